@@ -142,7 +142,7 @@ func main() {
 
 	// Example 4: List supported banks
 	fmt.Println("=== Supported Indonesian Banks ===")
-	for code, name := range constants.BanksIDR {
-		fmt.Printf("  %s: %s\n", code, name)
+	for _, bank := range constants.Banks(constants.CurrencyIDR) {
+		fmt.Printf("  %s: %s (%s)\n", bank.Code, bank.Name, bank.Type)
 	}
 }
@@ -16,21 +16,17 @@
 package main
 
 import (
-	"encoding/json"
+	"context"
 	"fmt"
 	"log"
 	"net/http"
 	"os"
 
 	"github.com/H0llyW00dzZ/gspay-go-sdk/src/client"
+	"github.com/H0llyW00dzZ/gspay-go-sdk/src/lifecycle"
 	"github.com/H0llyW00dzZ/gspay-go-sdk/src/payment"
 	"github.com/H0llyW00dzZ/gspay-go-sdk/src/payout"
-)
-
-var (
-	paymentSvc *payment.IDRService
-	usdtSvc    *payment.USDTService
-	payoutSvc  *payout.IDRService
+	"github.com/H0llyW00dzZ/gspay-go-sdk/src/webhook"
 )
 
 func main() {
@@ -44,19 +40,21 @@ func main() {
 
 	// Create client and services
 	c := client.New(authKey, secretKey)
-	paymentSvc = payment.NewIDRService(c)
-	usdtSvc = payment.NewUSDTService(c)
-	payoutSvc = payout.NewIDRService(c)
 
-	// Setup webhook handlers
-	http.HandleFunc("/webhook/payment/idr", handlePaymentCallbackIDR)
-	http.HandleFunc("/webhook/payout/idr", handlePayoutCallbackIDR)
-	http.HandleFunc("/webhook/payment/usdt", handlePaymentCallbackUSDT)
+	// One router verifies and dispatches every callback type
+	rt := webhook.NewRouter(
+		webhook.WithRoute(webhook.PaymentIDR(payment.NewIDRService(c), handlePaymentCallbackIDR)),
+		webhook.WithRoute(webhook.PaymentUSDT(payment.NewUSDTService(c), handlePaymentCallbackUSDT)),
+		webhook.WithRoute(webhook.PayoutIDR(payout.NewIDRService(c), handlePayoutCallbackIDR)),
+		webhook.WithSubscriber(logEvent),
+	)
+	http.Handle("/webhook/", rt)
 
 	// Start server
 	addr := ":8080"
 	fmt.Printf("Starting webhook server on %s\n", addr)
 	fmt.Println("Endpoints:")
+	fmt.Println("  POST /webhook              - any callback (detected from the payload)")
 	fmt.Println("  POST /webhook/payment/idr  - IDR payment callbacks")
 	fmt.Println("  POST /webhook/payout/idr   - IDR payout callbacks")
 	fmt.Println("  POST /webhook/payment/usdt - USDT payment callbacks")
@@ -64,30 +62,8 @@ func main() {
 	log.Fatal(http.ListenAndServe(addr, nil))
 }
 
-// handlePaymentCallbackIDR handles IDR payment webhook callbacks.
-func handlePaymentCallbackIDR(w http.ResponseWriter, r *http.Request) {
-	if r.Method != http.MethodPost {
-		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
-		return
-	}
-
-	var callback payment.IDRCallback
-	decoder := json.NewDecoder(r.Body)
-	decoder.UseNumber()
-	if err := decoder.Decode(&callback); err != nil {
-		log.Printf("Failed to decode callback: %v", err)
-		http.Error(w, "Invalid request body", http.StatusBadRequest)
-		return
-	}
-
-	// Verify signature
-	if err := paymentSvc.VerifyCallback(&callback); err != nil {
-		log.Printf("Invalid signature for transaction %s: %v", callback.TransactionID, err)
-		http.Error(w, "Invalid signature", http.StatusUnauthorized)
-		return
-	}
-
-	// Process the callback based on status
+// handlePaymentCallbackIDR handles verified IDR payment callbacks.
+func handlePaymentCallbackIDR(ctx context.Context, callback *payment.IDRCallback) error {
 	log.Printf("Received IDR payment callback: txn=%s, payment_id=%s, amount=%s, status=%s",
 		callback.TransactionID,
 		callback.IDRPaymentID,
@@ -111,35 +87,12 @@ func handlePaymentCallbackIDR(w http.ResponseWriter, r *http.Request) {
 		log.Printf("Payment pending: %s", callback.TransactionID)
 	}
 
-	// Respond with success
-	w.WriteHeader(http.StatusOK)
-	w.Write([]byte("OK"))
+	// Returning an error makes GSPAY2 redeliver the callback
+	return nil
 }
 
-// handlePayoutCallbackIDR handles IDR payout webhook callbacks.
-func handlePayoutCallbackIDR(w http.ResponseWriter, r *http.Request) {
-	if r.Method != http.MethodPost {
-		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
-		return
-	}
-
-	var callback payout.IDRCallback
-	decoder := json.NewDecoder(r.Body)
-	decoder.UseNumber()
-	if err := decoder.Decode(&callback); err != nil {
-		log.Printf("Failed to decode callback: %v", err)
-		http.Error(w, "Invalid request body", http.StatusBadRequest)
-		return
-	}
-
-	// Verify signature
-	if err := payoutSvc.VerifyCallback(&callback); err != nil {
-		log.Printf("Invalid signature for payout %s: %v", callback.TransactionID, err)
-		http.Error(w, "Invalid signature", http.StatusUnauthorized)
-		return
-	}
-
-	// Process the callback
+// handlePayoutCallbackIDR handles verified IDR payout callbacks.
+func handlePayoutCallbackIDR(ctx context.Context, callback *payout.IDRCallback) error {
 	log.Printf("Received IDR payout callback: txn=%s, payout_id=%s, account=%s, amount=%s",
 		callback.TransactionID,
 		callback.IDRPayoutID,
@@ -150,35 +103,11 @@ func handlePayoutCallbackIDR(w http.ResponseWriter, r *http.Request) {
 	// TODO: Implement your business logic here
 	// - Update withdrawal status
 	// - Notify user
-
-	w.WriteHeader(http.StatusOK)
-	w.Write([]byte("OK"))
+	return nil
 }
 
-// handlePaymentCallbackUSDT handles USDT payment webhook callbacks.
-func handlePaymentCallbackUSDT(w http.ResponseWriter, r *http.Request) {
-	if r.Method != http.MethodPost {
-		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
-		return
-	}
-
-	var callback payment.USDTCallback
-	decoder := json.NewDecoder(r.Body)
-	decoder.UseNumber()
-	if err := decoder.Decode(&callback); err != nil {
-		log.Printf("Failed to decode callback: %v", err)
-		http.Error(w, "Invalid request body", http.StatusBadRequest)
-		return
-	}
-
-	// Verify signature
-	if err := usdtSvc.VerifyCallback(&callback); err != nil {
-		log.Printf("Invalid signature for USDT payment %s: %v", callback.TransactionID, err)
-		http.Error(w, "Invalid signature", http.StatusUnauthorized)
-		return
-	}
-
-	// Process the callback
+// handlePaymentCallbackUSDT handles verified USDT payment callbacks.
+func handlePaymentCallbackUSDT(ctx context.Context, callback *payment.USDTCallback) error {
 	log.Printf("Received USDT payment callback: txn=%s, payment_id=%s, amount=%s, status=%s",
 		callback.TransactionID,
 		callback.CryptoPaymentID,
@@ -190,7 +119,11 @@ func handlePaymentCallbackUSDT(w http.ResponseWriter, r *http.Request) {
 		log.Printf("USDT Payment successful: %s", callback.TransactionID)
 		// TODO: Implement your business logic here
 	}
+	return nil
+}
 
-	w.WriteHeader(http.StatusOK)
-	w.Write([]byte("OK"))
+// logEvent receives the normalized event of every callback type.
+func logEvent(ctx context.Context, ev lifecycle.Event) error {
+	log.Printf("Lifecycle: %s %s is %s", ev.Kind, ev.TransactionID, ev.State)
+	return nil
 }
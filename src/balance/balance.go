@@ -20,6 +20,7 @@ import (
 
 	"github.com/H0llyW00dzZ/gspay-go-sdk/src/client"
 	"github.com/H0llyW00dzZ/gspay-go-sdk/src/constants"
+	"github.com/H0llyW00dzZ/gspay-go-sdk/src/errors"
 	"github.com/H0llyW00dzZ/gspay-go-sdk/src/i18n"
 )
 
@@ -38,21 +39,30 @@ type Service struct{ client *client.Client }
 func NewService(c *client.Client) *Service { return &Service{client: c} }
 
 // Get queries the operator's available settlement balance.
-func (s *Service) Get(ctx context.Context) (*Response, error) {
-	s.client.Logger().Debug(s.client.I18n(i18n.LogQueryingBalance))
+func (s *Service) Get(ctx context.Context, opts ...client.CallOption) (*Response, error) {
+	c := s.client.WithCallOptions(opts...)
+	c.Logger().Debug(c.I18n(i18n.LogQueryingBalance))
 
-	endpoint := fmt.Sprintf(constants.GetEndpoint(constants.EndpointBalance), s.client.AuthKey)
-	resp, err := s.client.Get(ctx, endpoint, nil)
+	c, err := c.Resolve(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	result, err := client.ParseData[Response](resp.Data, s.client.Language)
+	endpoint := fmt.Sprintf(constants.GetEndpoint(constants.EndpointBalance), c.AuthKey)
+	resp, err := c.Get(ctx, endpoint, nil)
 	if err != nil {
 		return nil, err
 	}
 
-	s.client.Logger().Info(s.client.I18n(i18n.LogBalanceRetrieved),
+	result, err := client.ParseData[Response](resp.Data, c.Language)
+	if err != nil {
+		return nil, err
+	}
+	if result == nil {
+		return nil, c.Error(errors.ErrEmptyResponse)
+	}
+
+	c.Logger().Info(c.I18n(i18n.LogBalanceRetrieved),
 		"idr_balance", result.Balance,
 		"usdt_balance", result.UsdtBalance,
 	)
@@ -21,6 +21,7 @@ import (
 	"testing"
 
 	"github.com/H0llyW00dzZ/gspay-go-sdk/src/client"
+	"github.com/H0llyW00dzZ/gspay-go-sdk/src/errors"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -67,4 +68,63 @@ func TestService_Get(t *testing.T) {
 
 		require.Error(t, err)
 	})
+
+	t.Run("resolves credentials per call", func(t *testing.T) {
+		var paths []string
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			paths = append(paths, r.URL.Path)
+			w.Header().Set("Content-Type", "application/json")
+			json.NewEncoder(w).Encode(map[string]any{
+				"code":    200,
+				"message": "success",
+				"data":    []map[string]float64{{"balance": 1.0}},
+			})
+		}))
+		defer server.Close()
+
+		store := client.NewMemorySecretStore()
+		store.Put("gspay", map[string]string{"auth_key": "first-auth", "secret_key": "s"})
+		creds := client.NewStoreCredentials(store, "gspay", 0)
+		svc := NewService(client.New("", "", client.WithBaseURL(server.URL), client.WithCredentials(creds)))
+
+		_, err := svc.Get(t.Context())
+		require.NoError(t, err)
+		store.Put("gspay", map[string]string{"auth_key": "second-auth", "secret_key": "s"})
+		_, err = svc.Get(t.Context())
+		require.NoError(t, err)
+
+		require.Len(t, paths, 2)
+		assert.Contains(t, paths[0], "first-auth")
+		assert.Contains(t, paths[1], "second-auth")
+	})
+
+	t.Run("exposes response metadata", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			w.Header().Set("X-Request-Id", "gspay-42")
+			json.NewEncoder(w).Encode(map[string]any{
+				"code":    200,
+				"message": "success",
+				"data":    []map[string]float64{{"balance": 1.0}},
+			})
+		}))
+		defer server.Close()
+
+		svc := NewService(client.New("auth-key", "secret-key", client.WithBaseURL(server.URL)))
+		var meta client.ResponseMeta
+		_, err := svc.Get(t.Context(), client.WithResponseMeta(&meta))
+		require.NoError(t, err)
+		assert.Equal(t, "gspay-42", meta.RequestID)
+		assert.Equal(t, http.StatusOK, meta.StatusCode)
+		assert.Equal(t, 1, meta.Attempts)
+		assert.Contains(t, string(meta.Body), `"balance":1`)
+	})
+
+	t.Run("fails when credentials are unavailable", func(t *testing.T) {
+		creds := client.NewStoreCredentials(client.NewMemorySecretStore(), "missing", 0)
+		svc := NewService(client.New("", "", client.WithCredentials(creds)))
+
+		_, err := svc.Get(t.Context())
+		assert.ErrorIs(t, err, errors.ErrCredentialsUnavailable)
+	})
 }
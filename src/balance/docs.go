@@ -28,6 +28,30 @@
 //	}
 //	fmt.Printf("Balance: %s\n", resp.Balance)
 //
+// # Watching the Balance
+//
+// [Watcher] polls the balance at an interval and caches the last known
+// IDR and USDT balances, so hot paths can read them with [Watcher.Latest]
+// without calling the API:
+//
+//	w := balance.NewWatcher(balanceSvc,
+//	    balance.WithInterval(30*time.Second),
+//	    balance.WithThreshold(balance.Threshold{
+//	        Currency: constants.CurrencyIDR,
+//	        Low:      5_000_000,
+//	        Recover:  10_000_000,
+//	        OnLow:    func(a balance.Alert) { log.Printf("IDR balance low: %.2f", a.Balance) },
+//	    }),
+//	)
+//	go w.Run(ctx) // stops when ctx is canceled
+//
+//	if snap, ok := w.Latest(); ok {
+//	    fmt.Printf("IDR: %.2f\n", snap.IDR)
+//	}
+//
+// Thresholds use hysteresis: OnLow fires once when the balance drops below
+// Low, and the threshold re-arms only after the balance reaches Recover.
+//
 // # Response
 //
 // The [Response] struct contains:
@@ -0,0 +1,247 @@
+// Copyright 2026 H0llyW00dzZ
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package balance
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/H0llyW00dzZ/gspay-go-sdk/src/constants"
+	"github.com/H0llyW00dzZ/gspay-go-sdk/src/i18n"
+)
+
+// DefaultWatchInterval is the default polling interval of a [Watcher].
+const DefaultWatchInterval = time.Minute
+
+// Snapshot is the last known operator balance.
+type Snapshot struct {
+	// IDR is the IDR settlement balance.
+	IDR float64 `json:"idr"`
+	// USDT is the USDT settlement balance.
+	USDT float64 `json:"usdt"`
+	// UpdatedAt is when the request that retrieved the balance was sent, so
+	// the balance reflects every payout completed before it.
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// Amount returns the balance for currency.
+// Returns 0 for currencies without a settlement balance.
+func (s Snapshot) Amount(currency constants.Currency) float64 {
+	switch currency {
+	case constants.CurrencyIDR:
+		return s.IDR
+	case constants.CurrencyUSDT:
+		return s.USDT
+	default:
+		return 0
+	}
+}
+
+// Alert describes a threshold crossing reported by a [Watcher].
+type Alert struct {
+	// Currency is the balance currency that crossed the threshold.
+	Currency constants.Currency
+	// Balance is the balance that triggered the alert.
+	Balance float64
+	// Threshold is the threshold that was crossed.
+	Threshold Threshold
+	// At is when the balance was retrieved.
+	At time.Time
+}
+
+// Threshold is a low-water mark with hysteresis.
+//
+// OnLow fires once when the balance drops below Low. The threshold is only
+// re-armed, firing OnRecover, once the balance rises to Recover or above.
+// Keeping Recover above Low prevents alerts from flapping when the balance
+// hovers around the mark.
+type Threshold struct {
+	// Currency is the balance currency to watch.
+	Currency constants.Currency
+	// Low is the low-water mark.
+	Low float64
+	// Recover is the balance at which the threshold re-arms.
+	// Values below Low are raised to Low.
+	Recover float64
+	// OnLow is called when the balance drops below Low (optional).
+	OnLow func(Alert)
+	// OnRecover is called when the balance recovers to Recover (optional).
+	OnRecover func(Alert)
+}
+
+// threshold tracks whether a [Threshold] is currently tripped.
+type threshold struct {
+	Threshold
+	low bool
+}
+
+// WatcherOption is a functional option for configuring the [Watcher].
+type WatcherOption func(*Watcher)
+
+// WithInterval sets the polling interval.
+// Default is [DefaultWatchInterval]. Non-positive values are ignored.
+func WithInterval(d time.Duration) WatcherOption {
+	return func(w *Watcher) {
+		if d > 0 {
+			w.interval = d
+		}
+	}
+}
+
+// WithThreshold adds a low-balance threshold.
+// It can be given multiple times to watch several marks or currencies.
+//
+// Example:
+//
+//	balance.WithThreshold(balance.Threshold{
+//	    Currency: constants.CurrencyIDR,
+//	    Low:      5_000_000,
+//	    Recover:  10_000_000,
+//	    OnLow:    func(a balance.Alert) { pager.Notify("IDR float low: %.2f", a.Balance) },
+//	})
+func WithThreshold(t Threshold) WatcherOption {
+	return func(w *Watcher) {
+		if t.Recover < t.Low {
+			t.Recover = t.Low
+		}
+		w.thresholds = append(w.thresholds, &threshold{Threshold: t})
+	}
+}
+
+// WithErrorHandler sets a function called when a poll fails.
+// The last known snapshot is kept when a poll fails.
+func WithErrorHandler(fn func(error)) WatcherOption {
+	return func(w *Watcher) {
+		w.onError = fn
+	}
+}
+
+// Watcher polls the operator balance and caches the last known value.
+//
+// Hot paths should read the cached balance with [Watcher.Latest] instead of
+// calling [Service.Get]. A Watcher is safe for concurrent use.
+type Watcher struct {
+	svc        *Service
+	interval   time.Duration
+	thresholds []*threshold
+	onError    func(error)
+
+	// pollMu serializes polls so threshold state is updated in order.
+	pollMu sync.Mutex
+
+	mu     sync.RWMutex
+	latest Snapshot
+	ok     bool
+}
+
+// NewWatcher creates a new balance [Watcher] using svc.
+//
+// Example:
+//
+//	w := balance.NewWatcher(balance.NewService(c),
+//	    balance.WithInterval(30*time.Second),
+//	    balance.WithThreshold(balance.Threshold{Currency: constants.CurrencyIDR, Low: 5_000_000}),
+//	)
+//	go w.Run(ctx)
+func NewWatcher(svc *Service, opts ...WatcherOption) *Watcher {
+	w := &Watcher{svc: svc, interval: DefaultWatchInterval}
+	for _, opt := range opts {
+		opt(w)
+	}
+	return w
+}
+
+// Latest returns the last known balance.
+// The boolean is false if no poll has succeeded yet.
+func (w *Watcher) Latest() (Snapshot, bool) {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	return w.latest, w.ok
+}
+
+// Run polls the balance immediately and then at every interval until ctx is canceled.
+// It always returns ctx.Err().
+func (w *Watcher) Run(ctx context.Context) error {
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := w.Poll(ctx); err != nil && w.onError != nil && ctx.Err() == nil {
+			w.onError(err)
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// Poll retrieves the balance once, updates the cache, and fires threshold callbacks.
+//
+// Callbacks run synchronously on the polling goroutine.
+func (w *Watcher) Poll(ctx context.Context) (Snapshot, error) {
+	w.pollMu.Lock()
+	defer w.pollMu.Unlock()
+
+	// Taken before the request: a payout settled while it is in flight may
+	// be missing from the balance, so it must not count as newer.
+	requestedAt := time.Now()
+	resp, err := w.svc.Get(ctx)
+	if err != nil {
+		w.svc.client.Logger().Warn(w.svc.client.I18n(i18n.LogBalancePollFailed), "error", err)
+		return Snapshot{}, err
+	}
+
+	snap := Snapshot{IDR: resp.Balance, USDT: resp.UsdtBalance, UpdatedAt: requestedAt}
+	w.mu.Lock()
+	w.latest, w.ok = snap, true
+	w.mu.Unlock()
+
+	for _, t := range w.thresholds {
+		w.check(t, snap)
+	}
+	return snap, nil
+}
+
+// check evaluates a threshold against snap. The caller must hold w.pollMu.
+func (w *Watcher) check(t *threshold, snap Snapshot) {
+	bal := snap.Amount(t.Currency)
+	alert := Alert{Currency: t.Currency, Balance: bal, Threshold: t.Threshold, At: snap.UpdatedAt}
+
+	switch {
+	case !t.low && bal < t.Low:
+		t.low = true
+		w.svc.client.Logger().Warn(w.svc.client.I18n(i18n.LogBalanceLow),
+			"currency", t.Currency,
+			"balance", bal,
+			"low", t.Low,
+		)
+		if t.OnLow != nil {
+			t.OnLow(alert)
+		}
+	case t.low && bal >= t.Recover:
+		t.low = false
+		w.svc.client.Logger().Info(w.svc.client.I18n(i18n.LogBalanceRecovered),
+			"currency", t.Currency,
+			"balance", bal,
+			"recover", t.Recover,
+		)
+		if t.OnRecover != nil {
+			t.OnRecover(alert)
+		}
+	}
+}
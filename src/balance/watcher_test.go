@@ -0,0 +1,195 @@
+// Copyright 2026 H0llyW00dzZ
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package balance
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/H0llyW00dzZ/gspay-go-sdk/src/client"
+	"github.com/H0llyW00dzZ/gspay-go-sdk/src/constants"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// balanceServer serves the IDR balances in sequence, repeating the last one.
+// A negative balance is answered with an API error.
+func balanceServer(t *testing.T, balances ...float64) (*Service, *atomic.Int32) {
+	t.Helper()
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		i := int(calls.Add(1)) - 1
+		bal := balances[min(i, len(balances)-1)]
+
+		w.Header().Set("Content-Type", "application/json")
+		if bal < 0 {
+			json.NewEncoder(w).Encode(map[string]any{"code": 500, "message": "internal server error"})
+			return
+		}
+		json.NewEncoder(w).Encode(map[string]any{
+			"code":    200,
+			"message": "success",
+			"data":    []map[string]float64{{"balance": bal, "usdt_balance": 12.5}},
+		})
+	}))
+	t.Cleanup(server.Close)
+
+	c := client.New("auth-key", "secret-key", client.WithBaseURL(server.URL), client.WithRetries(0))
+	return NewService(c), &calls
+}
+
+func TestWatcher_Poll(t *testing.T) {
+	t.Run("caches latest snapshot", func(t *testing.T) {
+		svc, _ := balanceServer(t, 100000)
+		w := NewWatcher(svc)
+
+		_, ok := w.Latest()
+		assert.False(t, ok)
+
+		snap, err := w.Poll(t.Context())
+		require.NoError(t, err)
+		assert.Equal(t, 100000.0, snap.IDR)
+		assert.Equal(t, 12.5, snap.USDT)
+		assert.False(t, snap.UpdatedAt.IsZero())
+
+		latest, ok := w.Latest()
+		assert.True(t, ok)
+		assert.Equal(t, snap, latest)
+		assert.Equal(t, 12.5, latest.Amount(constants.CurrencyUSDT))
+		assert.Zero(t, latest.Amount(constants.CurrencyTHB))
+	})
+
+	t.Run("timestamps the snapshot when the request is sent", func(t *testing.T) {
+		var answeredAt time.Time
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			time.Sleep(10 * time.Millisecond)
+			answeredAt = time.Now()
+			json.NewEncoder(w).Encode(map[string]any{
+				"code":    200,
+				"message": "success",
+				"data":    []map[string]float64{{"balance": 100000}},
+			})
+		}))
+		t.Cleanup(server.Close)
+		w := NewWatcher(NewService(client.New("auth-key", "secret-key", client.WithBaseURL(server.URL))))
+
+		snap, err := w.Poll(t.Context())
+		require.NoError(t, err)
+		assert.True(t, snap.UpdatedAt.Before(answeredAt), "a payout settled during the poll must count as newer")
+	})
+
+	t.Run("keeps last snapshot on failure", func(t *testing.T) {
+		svc, _ := balanceServer(t, 100000, -1)
+		w := NewWatcher(svc)
+
+		first, err := w.Poll(t.Context())
+		require.NoError(t, err)
+		_, err = w.Poll(t.Context())
+		require.Error(t, err)
+
+		latest, ok := w.Latest()
+		assert.True(t, ok)
+		assert.Equal(t, first, latest)
+	})
+}
+
+func TestWatcher_Thresholds(t *testing.T) {
+	// Balance dips below the mark, hovers around it, then recovers.
+	svc, _ := balanceServer(t, 20000, 9000, 10500, 9500, 14000, 15000, 8000)
+
+	var lows, recovers []float64
+	w := NewWatcher(svc, WithThreshold(Threshold{
+		Currency:  constants.CurrencyIDR,
+		Low:       10000,
+		Recover:   15000,
+		OnLow:     func(a Alert) { lows = append(lows, a.Balance) },
+		OnRecover: func(a Alert) { recovers = append(recovers, a.Balance) },
+	}))
+
+	for range 7 {
+		_, err := w.Poll(t.Context())
+		require.NoError(t, err)
+	}
+
+	assert.Equal(t, []float64{9000, 8000}, lows)
+	assert.Equal(t, []float64{15000}, recovers)
+
+	t.Run("recover defaults to low", func(t *testing.T) {
+		svc, _ := balanceServer(t, 9000, 10000)
+		var recovered bool
+		w := NewWatcher(svc, WithThreshold(Threshold{
+			Currency:  constants.CurrencyIDR,
+			Low:       10000,
+			OnRecover: func(Alert) { recovered = true },
+		}))
+		for range 2 {
+			_, err := w.Poll(t.Context())
+			require.NoError(t, err)
+		}
+		assert.True(t, recovered)
+	})
+}
+
+func TestWatcher_Run(t *testing.T) {
+	t.Run("polls until context is canceled", func(t *testing.T) {
+		svc, calls := balanceServer(t, 100000)
+		w := NewWatcher(svc, WithInterval(5*time.Millisecond))
+
+		ctx, cancel := context.WithCancel(t.Context())
+		done := make(chan error, 1)
+		go func() { done <- w.Run(ctx) }()
+
+		require.Eventually(t, func() bool { return calls.Load() >= 3 }, time.Second, time.Millisecond)
+		cancel()
+
+		select {
+		case err := <-done:
+			assert.ErrorIs(t, err, context.Canceled)
+		case <-time.After(time.Second):
+			t.Fatal("Run did not stop after cancellation")
+		}
+
+		_, ok := w.Latest()
+		assert.True(t, ok)
+	})
+
+	t.Run("reports poll errors", func(t *testing.T) {
+		svc, _ := balanceServer(t, -1)
+
+		var mu sync.Mutex
+		var errs []error
+		w := NewWatcher(svc, WithInterval(5*time.Millisecond), WithErrorHandler(func(err error) {
+			mu.Lock()
+			defer mu.Unlock()
+			errs = append(errs, err)
+		}))
+
+		ctx, cancel := context.WithCancel(t.Context())
+		defer cancel()
+		go w.Run(ctx)
+
+		require.Eventually(t, func() bool {
+			mu.Lock()
+			defer mu.Unlock()
+			return len(errs) > 0
+		}, time.Second, time.Millisecond)
+	})
+}
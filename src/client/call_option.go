@@ -0,0 +1,191 @@
+// Copyright 2026 H0llyW00dzZ
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"net/http"
+	"slices"
+	"time"
+
+	"github.com/H0llyW00dzZ/gspay-go-sdk/src/i18n"
+)
+
+// RetryPolicy decides whether a failed request attempt should be retried.
+//
+// statusCode is the HTTP status code of the response, or 0 if no response was
+// received (e.g., network errors). err is the error of the attempt; use
+// [errors.GetAPIError] to inspect API-level error codes.
+//
+// A retry policy only decides whether to retry; the number of attempts is
+// still bounded by the configured retry count.
+type RetryPolicy func(statusCode int, err error) bool
+
+// CallOption is a functional option that overrides client settings for a single call.
+//
+// Call options are accepted by [Client.DoRequest], [Client.Post], [Client.Get],
+// and every service method. They never modify the shared [Client].
+//
+// Example:
+//
+//	// Quick status poll: 2s timeout, no retries
+//	status, err := paymentSvc.GetStatus(ctx, txID,
+//	    client.WithCallTimeout(2*time.Second),
+//	    client.WithCallRetries(0),
+//	)
+type CallOption func(*Client)
+
+// WithCallTimeout sets the timeout for the whole call, including retries.
+//
+// The per-attempt timeout of the underlying HTTP client still applies,
+// so this can only shorten the time spent on a call.
+func WithCallTimeout(timeout time.Duration) CallOption {
+	return func(c *Client) {
+		if timeout > 0 {
+			c.callTimeout = timeout
+		}
+	}
+}
+
+// WithCallRetries sets the number of retry attempts for the call.
+// Use 0 to disable retries. Negative values are ignored.
+func WithCallRetries(retries int) CallOption {
+	return func(c *Client) {
+		if retries >= 0 {
+			c.Retries = retries
+		}
+	}
+}
+
+// WithCallRetryPolicy sets the policy that decides which failures are retried for the call.
+//
+// By default, network errors, 5xx, 404, and 429 responses are retried.
+//
+// Example:
+//
+//	// Only retry network errors
+//	client.WithCallRetryPolicy(func(statusCode int, err error) bool {
+//	    return statusCode == 0
+//	})
+func WithCallRetryPolicy(policy RetryPolicy) CallOption {
+	return func(c *Client) {
+		c.retryPolicy = policy
+	}
+}
+
+// WithCallLanguage sets the language for error and log messages of the call.
+// Invalid languages are ignored.
+func WithCallLanguage(lang i18n.Language) CallOption {
+	return func(c *Client) {
+		if lang.IsValid() {
+			c.Language = lang
+		}
+	}
+}
+
+// WithCallHeader adds an HTTP header to the requests of the call.
+//
+// Headers are applied after the SDK defaults, so they can override them.
+func WithCallHeader(key, value string) CallOption {
+	return func(c *Client) {
+		if c.headers == nil {
+			c.headers = make(http.Header)
+		}
+		c.headers.Add(key, value)
+	}
+}
+
+// WithCorrelationID adds a "correlationID" key to every log entry of the call.
+//
+// Use it to tie SDK logs to the request that triggered the call.
+func WithCorrelationID(id string) CallOption {
+	return func(c *Client) {
+		if id != "" {
+			c.logger = correlatedLogger{Logger: c.logger, id: id}
+		}
+	}
+}
+
+// WithCallbackSourceIP sets the source IP of the callback being verified.
+//
+// VerifyCallbackBytes checks it against [WithCallbackIPWhitelist] before the
+// signature. VerifyCallbackRequest uses [http.Request.RemoteAddr] unless this
+// option is given, e.g. with the client IP resolved by a trusted proxy.
+func WithCallbackSourceIP(ip string) CallOption {
+	return func(c *Client) {
+		c.callbackSourceIP = &ip
+	}
+}
+
+// withLogger overrides the logger for the call.
+func withLogger(l Logger) CallOption {
+	return func(c *Client) {
+		c.logger = l
+	}
+}
+
+// WithCallOptions returns a copy of the client with opts applied.
+//
+// The copy shares the HTTP client and configuration with c, so it is cheap
+// and intended to be used for a single call. If opts is empty, c itself is
+// returned. Services use this to apply per-call options.
+func (c *Client) WithCallOptions(opts ...CallOption) *Client {
+	if len(opts) == 0 {
+		return c
+	}
+	cp := *c
+	cp.headers = cloneHeader(c.headers)
+	for _, opt := range opts {
+		opt(&cp)
+	}
+	return &cp
+}
+
+// cloneHeader returns a deep copy of h, or nil if h is empty.
+func cloneHeader(h http.Header) http.Header {
+	if len(h) == 0 {
+		return nil
+	}
+	cp := make(http.Header, len(h))
+	for k, v := range h {
+		cp[k] = slices.Clone(v)
+	}
+	return cp
+}
+
+// correlatedLogger adds a correlation ID to every log entry.
+type correlatedLogger struct {
+	Logger
+	id string
+}
+
+func (l correlatedLogger) with(keysAndValues []any) []any {
+	return append(slices.Clip(keysAndValues), "correlationID", l.id)
+}
+
+func (l correlatedLogger) Debug(msg string, keysAndValues ...any) {
+	l.Logger.Debug(msg, l.with(keysAndValues)...)
+}
+
+func (l correlatedLogger) Info(msg string, keysAndValues ...any) {
+	l.Logger.Info(msg, l.with(keysAndValues)...)
+}
+
+func (l correlatedLogger) Warn(msg string, keysAndValues ...any) {
+	l.Logger.Warn(msg, l.with(keysAndValues)...)
+}
+
+func (l correlatedLogger) Error(msg string, keysAndValues ...any) {
+	l.Logger.Error(msg, l.with(keysAndValues)...)
+}
@@ -0,0 +1,186 @@
+// Copyright 2026 H0llyW00dzZ
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/H0llyW00dzZ/gspay-go-sdk/src/errors"
+	"github.com/H0llyW00dzZ/gspay-go-sdk/src/i18n"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// countingServer answers every request with status and counts the attempts.
+func countingServer(t *testing.T, status int, handler func(r *http.Request)) (*httptest.Server, *atomic.Int32) {
+	t.Helper()
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		if handler != nil {
+			handler(r)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		json.NewEncoder(w).Encode(map[string]any{"code": status, "message": http.StatusText(status)})
+	}))
+	t.Cleanup(server.Close)
+	return server, &calls
+}
+
+func TestWithCallOptions(t *testing.T) {
+	t.Run("returns same client without options", func(t *testing.T) {
+		c := New("auth-key", "secret-key")
+		assert.Same(t, c, c.WithCallOptions())
+	})
+
+	t.Run("does not modify the shared client", func(t *testing.T) {
+		c := New("auth-key", "secret-key", WithRetries(3))
+		call := c.WithCallOptions(
+			WithCallRetries(0),
+			WithCallLanguage(i18n.Indonesian),
+			WithCallHeader("X-Request-ID", "abc"),
+		)
+		assert.Equal(t, 0, call.Retries)
+		assert.Equal(t, i18n.Indonesian, call.Language)
+		assert.Equal(t, 3, c.Retries)
+		assert.Equal(t, i18n.English, c.Language)
+		assert.Nil(t, c.headers)
+
+		again := call.WithCallOptions(WithCallHeader("X-Other", "1"))
+		assert.Empty(t, call.headers.Get("X-Other"))
+		assert.Equal(t, "abc", again.headers.Get("X-Request-ID"))
+	})
+
+	t.Run("ignores invalid values", func(t *testing.T) {
+		c := New("auth-key", "secret-key", WithRetries(2))
+		call := c.WithCallOptions(
+			WithCallRetries(-1),
+			WithCallTimeout(-time.Second),
+			WithCallLanguage("xx"),
+			WithCorrelationID(""),
+		)
+		assert.Equal(t, 2, call.Retries)
+		assert.Zero(t, call.callTimeout)
+		assert.Equal(t, i18n.English, call.Language)
+		assert.Equal(t, c.logger, call.logger)
+	})
+}
+
+func TestDoRequest_CallOptions(t *testing.T) {
+	t.Run("overrides retry count", func(t *testing.T) {
+		server, calls := countingServer(t, http.StatusInternalServerError, nil)
+		c := New("auth-key", "secret-key", WithBaseURL(server.URL), WithRetries(2), WithRetryWait(time.Millisecond, time.Millisecond))
+
+		_, err := c.Get(t.Context(), "/test", nil, WithCallRetries(0))
+		require.Error(t, err)
+		assert.Equal(t, int32(1), calls.Load())
+
+		calls.Store(0)
+		_, err = c.Get(t.Context(), "/test", nil)
+		require.Error(t, err)
+		assert.Equal(t, int32(3), calls.Load())
+	})
+
+	t.Run("retry policy decides which failures are retried", func(t *testing.T) {
+		server, calls := countingServer(t, http.StatusBadRequest, nil)
+		c := New("auth-key", "secret-key", WithBaseURL(server.URL), WithRetries(2), WithRetryWait(time.Millisecond, time.Millisecond))
+
+		var seen []int
+		_, err := c.Post(t.Context(), "/test", nil, WithCallRetryPolicy(func(statusCode int, err error) bool {
+			seen = append(seen, statusCode)
+			return statusCode == http.StatusBadRequest
+		}))
+		require.Error(t, err)
+		assert.Equal(t, int32(3), calls.Load())
+		assert.Equal(t, []int{400, 400, 400}, seen)
+
+		server500, calls500 := countingServer(t, http.StatusInternalServerError, nil)
+		c = New("auth-key", "secret-key", WithBaseURL(server500.URL), WithRetries(2), WithRetryWait(time.Millisecond, time.Millisecond))
+		_, err = c.Get(t.Context(), "/test", nil, WithCallRetryPolicy(func(int, error) bool { return false }))
+		require.Error(t, err)
+		assert.Equal(t, int32(1), calls500.Load())
+	})
+
+	t.Run("timeout bounds the whole call", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			select {
+			case <-r.Context().Done():
+			case <-time.After(time.Second):
+			}
+		}))
+		t.Cleanup(server.Close)
+		c := New("auth-key", "secret-key", WithBaseURL(server.URL), WithRetries(0))
+
+		start := time.Now()
+		_, err := c.Get(t.Context(), "/test", nil, WithCallTimeout(50*time.Millisecond))
+		require.Error(t, err)
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+		assert.Less(t, time.Since(start), 500*time.Millisecond)
+	})
+
+	t.Run("sends extra headers", func(t *testing.T) {
+		server, _ := countingServer(t, http.StatusOK, func(r *http.Request) {
+			assert.Equal(t, "abc", r.Header.Get("X-Request-ID"))
+			assert.Equal(t, "application/json", r.Header.Get("Accept"))
+		})
+		c := New("auth-key", "secret-key", WithBaseURL(server.URL))
+
+		_, err := c.Get(t.Context(), "/test", nil, WithCallHeader("X-Request-ID", "abc"))
+		require.NoError(t, err)
+	})
+
+	t.Run("localizes errors with the call language", func(t *testing.T) {
+		server, _ := countingServer(t, http.StatusBadRequest, nil)
+		c := New("auth-key", "secret-key", WithBaseURL(server.URL), WithRetries(0))
+
+		_, err := c.Get(t.Context(), "/test", nil, WithCallLanguage(i18n.Indonesian))
+		require.Error(t, err)
+		apiErr := errors.GetAPIError(err)
+		require.NotNil(t, apiErr)
+		assert.Equal(t, i18n.Indonesian, apiErr.Lang)
+		assert.Contains(t, err.Error(), "permintaan gagal")
+	})
+
+	t.Run("adds correlation ID to logs", func(t *testing.T) {
+		server, _ := countingServer(t, http.StatusOK, nil)
+		mock := &MockLogger{}
+		c := New("auth-key", "secret-key", WithBaseURL(server.URL), WithLogger(mock))
+
+		_, err := c.Get(t.Context(), "/test", nil, WithCorrelationID("req-42"))
+		require.NoError(t, err)
+
+		require.NotEmpty(t, mock.DebugCalls)
+		for _, call := range append(mock.DebugCalls, mock.InfoCalls...) {
+			kv := call.KeysAndValues
+			require.GreaterOrEqual(t, len(kv), 2)
+			assert.Equal(t, "correlationID", kv[len(kv)-2])
+			assert.Equal(t, "req-42", kv[len(kv)-1])
+		}
+
+		mock.DebugCalls, mock.InfoCalls = nil, nil
+		_, err = c.Get(t.Context(), "/test", nil)
+		require.NoError(t, err)
+		for _, call := range mock.DebugCalls {
+			assert.NotContains(t, call.KeysAndValues, "correlationID")
+		}
+	})
+}
@@ -15,7 +15,12 @@
 package client
 
 import (
+	"bytes"
+	"encoding/json"
+	"io"
 	"net"
+	"net/http"
+	"strconv"
 
 	"github.com/H0llyW00dzZ/gspay-go-sdk/src/errors"
 )
@@ -49,3 +54,113 @@ func (c *Client) VerifyCallbackIP(ipStr string) error {
 
 	return nil
 }
+
+// ReadCallback reads the body of a callback request, up to the size set by
+// [WithMaxCallbackSize]. A larger body fails with [errors.ErrCallbackTooLarge].
+func (c *Client) ReadCallback(r *http.Request) ([]byte, error) {
+	body, err := io.ReadAll(io.LimitReader(r.Body, c.maxCallbackSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(body)) > c.maxCallbackSize {
+		return nil, c.callbackTooLarge()
+	}
+	return body, nil
+}
+
+// DecodeCallback decodes a raw callback body into v.
+//
+// Numbers are kept exactly as sent, so [json.Number] fields such as amounts
+// are not reformatted. The body must hold a single JSON object and be no
+// larger than the size set by [WithMaxCallbackSize]. With
+// [WithStrictCallbacks], keys that v does not declare and keys repeated in
+// the same object are rejected.
+//
+// Decoding errors wrap [errors.ErrInvalidJSON].
+func (c *Client) DecodeCallback(body []byte, v any) error {
+	if int64(len(body)) > c.maxCallbackSize {
+		return c.callbackTooLarge()
+	}
+	if c.strictCallbacks {
+		if key, ok := duplicateKey(body); ok {
+			return c.Error(errors.ErrInvalidJSON, c.Error(errors.ErrDuplicateKey, strconv.Quote(key)))
+		}
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(body))
+	dec.UseNumber()
+	if c.strictCallbacks {
+		dec.DisallowUnknownFields()
+	}
+	if err := dec.Decode(v); err != nil {
+		return c.Error(errors.ErrInvalidJSON, err)
+	}
+	if _, err := dec.Token(); err != io.EOF {
+		return c.Error(errors.ErrInvalidJSON, "trailing data")
+	}
+	return nil
+}
+
+// MaxCallbackSize returns the callback body size limit in bytes.
+// See [WithMaxCallbackSize].
+func (c *Client) MaxCallbackSize() int64 {
+	return c.maxCallbackSize
+}
+
+// CallbackSourceIP returns the source IP set with [WithCallbackSourceIP],
+// and whether one was set.
+func (c *Client) CallbackSourceIP() (string, bool) {
+	if c.callbackSourceIP == nil {
+		return "", false
+	}
+	return *c.callbackSourceIP, true
+}
+
+// callbackTooLarge returns the error for a callback body over the size limit.
+func (c *Client) callbackTooLarge() error {
+	return c.Error(errors.ErrCallbackTooLarge, strconv.FormatInt(c.maxCallbackSize, 10)+" bytes")
+}
+
+// duplicateKey returns the first key repeated within one object of body.
+// Malformed JSON is left for the decoder to report.
+func duplicateKey(body []byte) (string, bool) {
+	// frame is an open object (keys != nil) or array.
+	type frame struct {
+		keys      map[string]struct{}
+		expectKey bool
+	}
+	var stack []frame
+
+	dec := json.NewDecoder(bytes.NewReader(body))
+	for {
+		tok, err := dec.Token()
+		if err != nil {
+			return "", false
+		}
+		if top := len(stack) - 1; top >= 0 && stack[top].keys != nil {
+			if stack[top].expectKey {
+				if key, ok := tok.(string); ok {
+					if _, dup := stack[top].keys[key]; dup {
+						return key, true
+					}
+					stack[top].keys[key] = struct{}{}
+					stack[top].expectKey = false
+					continue
+				}
+			} else {
+				// tok starts the value of the last key
+				stack[top].expectKey = true
+			}
+		}
+		if delim, ok := tok.(json.Delim); ok {
+			switch delim {
+			case '{':
+				stack = append(stack, frame{keys: make(map[string]struct{}), expectKey: true})
+			case '[':
+				stack = append(stack, frame{})
+			default:
+				stack = stack[:len(stack)-1]
+			}
+		}
+	}
+}
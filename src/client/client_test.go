@@ -18,7 +18,10 @@ import (
 	"crypto/md5"
 	"crypto/sha256"
 	"crypto/sha512"
+	"encoding/json"
 	"net/http"
+	"net/http/httptest"
+	"strings"
 	"testing"
 	"time"
 
@@ -78,6 +81,60 @@ func TestNew(t *testing.T) {
 	})
 }
 
+func TestNewE(t *testing.T) {
+	t.Run("accepts valid options", func(t *testing.T) {
+		c, err := NewE("auth-key", "secret-key",
+			WithBaseURL("https://custom.api.com/"),
+			WithTimeout(10*time.Second),
+			WithRetries(0),
+			WithRetryWait(time.Second, time.Second),
+			WithCallbackIPWhitelist("10.0.0.0/8", "::1"),
+			WithLanguage(i18n.Indonesian),
+		)
+		require.NoError(t, err)
+		assert.Equal(t, 10*time.Second, c.Timeout)
+		assert.Equal(t, 0, c.Retries)
+	})
+
+	t.Run("reports every rejected option", func(t *testing.T) {
+		_, err := NewE("auth-key", "secret-key",
+			WithBaseURL("api.example.com"),
+			WithTimeout(time.Second),
+			WithRetries(-1),
+			WithRetryWait(5*time.Second, time.Second),
+			WithCallbackIPWhitelist("10.0.0.1", "10.0.0.300"),
+			WithLanguage("fr"),
+		)
+		require.Error(t, err)
+		assert.ErrorIs(t, err, errors.ErrInvalidConfig)
+		assert.Equal(t, []string{
+			"base_url",
+			"timeout",
+			"retries",
+			"retry_wait_max",
+			"callback_ip_whitelist[1]",
+			"language",
+		}, validationFields(t, err))
+	})
+
+	t.Run("reports negative retry waits", func(t *testing.T) {
+		_, err := NewE("auth-key", "secret-key", WithRetryWait(-time.Second, -time.Second))
+		assert.Equal(t, []string{"retry_wait_min", "retry_wait_max"}, validationFields(t, err))
+	})
+
+	t.Run("localizes errors in the client language", func(t *testing.T) {
+		_, err := NewE("auth-key", "secret-key", WithLanguage(i18n.Indonesian), WithRetries(-1))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), i18n.Get(i18n.Indonesian, i18n.MsgConfigNegative))
+	})
+
+	t.Run("New stays lenient", func(t *testing.T) {
+		c := New("auth-key", "secret-key", WithTimeout(time.Second), WithLanguage("fr"))
+		assert.Equal(t, time.Duration(constants.DefaultTimeout)*time.Second, c.Timeout)
+		assert.Equal(t, i18n.English, c.Language)
+	})
+}
+
 func TestGenerateSignature(t *testing.T) {
 	c := New("auth-key", "secret-key")
 
@@ -305,6 +362,26 @@ func TestWithLanguage(t *testing.T) {
 	})
 }
 
+func TestWithServerLocation(t *testing.T) {
+	t.Run("defaults to WIB", func(t *testing.T) {
+		c := New("auth", "secret")
+		assert.Equal(t, constants.DefaultServerLocation, c.ServerLocation())
+	})
+
+	t.Run("sets location", func(t *testing.T) {
+		c := New("auth", "secret", WithServerLocation(time.UTC))
+		assert.Equal(t, time.UTC, c.ServerLocation())
+	})
+
+	t.Run("rejects nil location", func(t *testing.T) {
+		c := New("auth", "secret", WithServerLocation(nil))
+		assert.Equal(t, constants.DefaultServerLocation, c.ServerLocation())
+
+		_, err := NewE("auth", "secret", WithServerLocation(nil))
+		assert.ErrorIs(t, err, errors.ErrInvalidConfig)
+	})
+}
+
 func TestIsIPWhitelisted(t *testing.T) {
 	t.Run("allows all IPs when whitelist is empty", func(t *testing.T) {
 		c := New("auth", "secret")
@@ -408,6 +485,115 @@ func TestVerifyCallbackIP(t *testing.T) {
 	})
 }
 
+func TestDecodeCallback(t *testing.T) {
+	type callback struct {
+		ID     json.Number `json:"id"`
+		Amount json.Number `json:"amount"`
+		Meta   struct {
+			Tags []string `json:"tags"`
+		} `json:"meta"`
+	}
+
+	t.Run("keeps numbers as sent", func(t *testing.T) {
+		c := New("auth", "secret")
+
+		var cb callback
+		require.NoError(t, c.DecodeCallback([]byte(`{"id":166812,"amount":10000.50,"extra":true}`), &cb))
+		assert.Equal(t, json.Number("166812"), cb.ID)
+		assert.Equal(t, json.Number("10000.50"), cb.Amount)
+	})
+
+	t.Run("rejects malformed and trailing data", func(t *testing.T) {
+		c := New("auth", "secret")
+
+		var cb callback
+		assert.ErrorIs(t, c.DecodeCallback([]byte(`{"id":`), &cb), errors.ErrInvalidJSON)
+		assert.ErrorIs(t, c.DecodeCallback([]byte(`{"id":1}{"id":2}`), &cb), errors.ErrInvalidJSON)
+	})
+
+	t.Run("rejects oversized body", func(t *testing.T) {
+		c := New("auth", "secret", WithMaxCallbackSize(8))
+
+		var cb callback
+		err := c.DecodeCallback([]byte(`{"id":166812}`), &cb)
+		assert.ErrorIs(t, err, errors.ErrCallbackTooLarge)
+	})
+
+	t.Run("strict rejects unknown keys", func(t *testing.T) {
+		c := New("auth", "secret", WithStrictCallbacks(true))
+
+		var cb callback
+		err := c.DecodeCallback([]byte(`{"id":1,"extra":true}`), &cb)
+		assert.ErrorIs(t, err, errors.ErrInvalidJSON)
+		assert.Contains(t, err.Error(), "extra")
+	})
+
+	t.Run("strict rejects duplicate keys", func(t *testing.T) {
+		c := New("auth", "secret", WithStrictCallbacks(true), WithLanguage(i18n.Indonesian))
+
+		for name, body := range map[string]string{
+			"top level": `{"id":1,"amount":10000,"amount":99999999}`,
+			"nested":    `{"id":1,"meta":{"tags":["a",{"x":1}],"tags":[]}}`,
+		} {
+			t.Run(name, func(t *testing.T) {
+				var cb callback
+				err := c.DecodeCallback([]byte(body), &cb)
+				assert.ErrorIs(t, err, errors.ErrInvalidJSON)
+				assert.ErrorIs(t, err, errors.ErrDuplicateKey)
+				assert.Contains(t, err.Error(), "kunci JSON duplikat")
+			})
+		}
+	})
+
+	t.Run("strict accepts the same key in different objects", func(t *testing.T) {
+		c := New("auth", "secret", WithStrictCallbacks(true))
+
+		var cb struct {
+			ID   json.Number `json:"id"`
+			Meta struct {
+				ID json.Number `json:"id"`
+			} `json:"meta"`
+		}
+		require.NoError(t, c.DecodeCallback([]byte(`{"id":1,"meta":{"id":2}}`), &cb))
+		assert.Equal(t, json.Number("2"), cb.Meta.ID)
+	})
+}
+
+func TestReadCallback(t *testing.T) {
+	t.Run("reads body within limit", func(t *testing.T) {
+		c := New("auth", "secret", WithMaxCallbackSize(16))
+
+		body, err := c.ReadCallback(httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`{"id":1}`)))
+		require.NoError(t, err)
+		assert.Equal(t, `{"id":1}`, string(body))
+	})
+
+	t.Run("rejects body over limit", func(t *testing.T) {
+		c := New("auth", "secret", WithMaxCallbackSize(4))
+
+		_, err := c.ReadCallback(httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`{"id":1}`)))
+		assert.ErrorIs(t, err, errors.ErrCallbackTooLarge)
+	})
+
+	t.Run("rejects invalid limit", func(t *testing.T) {
+		_, err := NewE("auth", "secret", WithMaxCallbackSize(0))
+		assert.ErrorIs(t, err, errors.ErrInvalidConfig)
+	})
+}
+
+func TestWithCallbackSourceIP(t *testing.T) {
+	c := New("auth", "secret")
+	_, ok := c.CallbackSourceIP()
+	assert.False(t, ok)
+
+	ip, ok := c.WithCallOptions(WithCallbackSourceIP("203.0.113.7")).CallbackSourceIP()
+	assert.True(t, ok)
+	assert.Equal(t, "203.0.113.7", ip)
+
+	_, ok = c.CallbackSourceIP()
+	assert.False(t, ok, "call option must not modify the shared client")
+}
+
 func TestLogAccountNumber(t *testing.T) {
 	t.Run("debug mode shows full account number", func(t *testing.T) {
 		c := New("auth", "secret", WithDebug(true))
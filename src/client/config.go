@@ -0,0 +1,279 @@
+// Copyright 2026 H0llyW00dzZ
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/H0llyW00dzZ/gspay-go-sdk/src/constants"
+	"github.com/H0llyW00dzZ/gspay-go-sdk/src/errors"
+	"github.com/H0llyW00dzZ/gspay-go-sdk/src/i18n"
+)
+
+// DefaultEnvPrefix is the environment variable prefix used by
+// [ConfigFromEnv] when none is given.
+const DefaultEnvPrefix = "GSPAY"
+
+// Duration is a [time.Duration] that is written and read as a string such
+// as "30s" or "1m30s" in JSON, YAML, and other text formats.
+type Duration time.Duration
+
+// MarshalText implements [encoding.TextMarshaler].
+func (d Duration) MarshalText() ([]byte, error) {
+	return []byte(time.Duration(d).String()), nil
+}
+
+// UnmarshalText implements [encoding.TextUnmarshaler].
+func (d *Duration) UnmarshalText(text []byte) error {
+	v, err := time.ParseDuration(string(text))
+	if err != nil {
+		return err
+	}
+	*d = Duration(v)
+	return nil
+}
+
+// Config is a serializable client configuration. Zero values mean "use the
+// default", so a config file only needs the settings it changes:
+//
+//	{
+//	    "auth_key": "...",
+//	    "secret_key": "...",
+//	    "timeout": "10s",
+//	    "retries": 2,
+//	    "callback_ip_whitelist": ["203.0.113.0/24"],
+//	    "language": "id"
+//	}
+type Config struct {
+	// AuthKey is the operator authentication key.
+	AuthKey string `json:"auth_key" yaml:"auth_key"`
+	// SecretKey is the operator secret key.
+	SecretKey string `json:"secret_key" yaml:"secret_key"`
+	// BaseURL overrides the API base URL.
+	BaseURL string `json:"base_url,omitempty" yaml:"base_url,omitempty"`
+	// Timeout is the per-request timeout; at least 5 seconds.
+	Timeout Duration `json:"timeout,omitempty" yaml:"timeout,omitempty"`
+	// Retries is the number of retry attempts. Nil uses the default; 0 disables retries.
+	Retries *int `json:"retries,omitempty" yaml:"retries,omitempty"`
+	// RetryWaitMin is the minimum wait between retries.
+	RetryWaitMin Duration `json:"retry_wait_min,omitempty" yaml:"retry_wait_min,omitempty"`
+	// RetryWaitMax is the maximum wait between retries.
+	RetryWaitMax Duration `json:"retry_wait_max,omitempty" yaml:"retry_wait_max,omitempty"`
+	// CallbackIPWhitelist lists allowed callback IP addresses or CIDR ranges.
+	CallbackIPWhitelist []string `json:"callback_ip_whitelist,omitempty" yaml:"callback_ip_whitelist,omitempty"`
+	// Language is the language for SDK messages ("en" or "id").
+	Language i18n.Language `json:"language,omitempty" yaml:"language,omitempty"`
+	// ServerTimezone is the IANA time zone of GSPAY2 dates, such as "Asia/Jakarta".
+	ServerTimezone string `json:"server_timezone,omitempty" yaml:"server_timezone,omitempty"`
+	// MaxResponseSize caps the size of a response body in bytes.
+	MaxResponseSize int64 `json:"max_response_size,omitempty" yaml:"max_response_size,omitempty"`
+	// MaxCallbackSize caps the size of a callback body in bytes.
+	MaxCallbackSize int64 `json:"max_callback_size,omitempty" yaml:"max_callback_size,omitempty"`
+	// StrictCallbacks rejects unknown and duplicate keys in callback bodies.
+	StrictCallbacks bool `json:"strict_callbacks,omitempty" yaml:"strict_callbacks,omitempty"`
+	// Debug enables debug logging with unsanitized values.
+	Debug bool `json:"debug,omitempty" yaml:"debug,omitempty"`
+}
+
+// NewFromConfig validates cfg and creates a client from it. Extra options,
+// such as [WithLogger] or [WithCredentials], are applied after the config.
+//
+// Unlike the individual options, which silently ignore out-of-range values,
+// every invalid setting is reported. The returned error joins one
+// [errors.ValidationError] per problem, each matching [errors.ErrInvalidConfig].
+//
+// The keys may be left empty when [WithCredentials] supplies them.
+func NewFromConfig(cfg Config, opts ...Option) (*Client, error) {
+	c := New(cfg.AuthKey, cfg.SecretKey, append(cfg.Options(), opts...)...)
+	if err := cfg.check(c, c.credentials == nil); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// Options converts the config into client options. It does not validate;
+// use [Config.Validate] or [NewFromConfig] for that.
+func (cfg Config) Options() []Option {
+	var opts []Option
+	if cfg.BaseURL != "" {
+		opts = append(opts, WithBaseURL(cfg.BaseURL))
+	}
+	if cfg.Timeout != 0 {
+		opts = append(opts, WithTimeout(time.Duration(cfg.Timeout)))
+	}
+	if cfg.Retries != nil {
+		opts = append(opts, WithRetries(*cfg.Retries))
+	}
+	if cfg.RetryWaitMin != 0 || cfg.RetryWaitMax != 0 {
+		waitMin, waitMax := cfg.retryWait()
+		opts = append(opts, WithRetryWait(waitMin, waitMax))
+	}
+	if len(cfg.CallbackIPWhitelist) > 0 {
+		opts = append(opts, WithCallbackIPWhitelist(cfg.CallbackIPWhitelist...))
+	}
+	if cfg.Language != "" {
+		opts = append(opts, WithLanguage(cfg.Language))
+	}
+	if cfg.ServerTimezone != "" {
+		opts = append(opts, withServerTimezone(cfg.ServerTimezone))
+	}
+	if cfg.MaxResponseSize != 0 {
+		opts = append(opts, WithMaxResponseSize(cfg.MaxResponseSize))
+	}
+	if cfg.MaxCallbackSize != 0 {
+		opts = append(opts, WithMaxCallbackSize(cfg.MaxCallbackSize))
+	}
+	if cfg.StrictCallbacks {
+		opts = append(opts, WithStrictCallbacks(true))
+	}
+	if cfg.Debug {
+		opts = append(opts, WithDebug(true))
+	}
+	return opts
+}
+
+// Validate reports every invalid setting in cfg, including missing keys.
+func (cfg Config) Validate() error {
+	return cfg.validate(true)
+}
+
+// retryWait returns the effective retry waits, filling in defaults.
+func (cfg Config) retryWait() (time.Duration, time.Duration) {
+	waitMin := time.Duration(constants.DefaultRetryWaitMin) * time.Millisecond
+	waitMax := time.Duration(constants.DefaultRetryWaitMax) * time.Millisecond
+	if cfg.RetryWaitMin != 0 {
+		waitMin = time.Duration(cfg.RetryWaitMin)
+	}
+	if cfg.RetryWaitMax != 0 {
+		waitMax = time.Duration(cfg.RetryWaitMax)
+	}
+	return waitMin, waitMax
+}
+
+// validate checks cfg; requireKeys is false when credentials come from elsewhere.
+func (cfg Config) validate(requireKeys bool) error {
+	return cfg.check(New(cfg.AuthKey, cfg.SecretKey, cfg.Options()...), requireKeys)
+}
+
+// check reports missing keys and the values rejected while building c from cfg.
+func (cfg Config) check(c *Client, requireKeys bool) error {
+	var issues []optionError
+	if requireKeys && cfg.AuthKey == "" {
+		issues = append(issues, optionError{field: "auth_key", key: i18n.MsgConfigRequired})
+	}
+	if requireKeys && cfg.SecretKey == "" {
+		issues = append(issues, optionError{field: "secret_key", key: i18n.MsgConfigRequired})
+	}
+	return joinOptionErrors(c.Language, append(issues, c.optErrs...))
+}
+
+// ConfigFromEnv reads a [Config] from environment variables named with the
+// given prefix (default [DefaultEnvPrefix]):
+//
+//	GSPAY_AUTH_KEY, GSPAY_SECRET_KEY, GSPAY_BASE_URL,
+//	GSPAY_TIMEOUT, GSPAY_RETRIES, GSPAY_RETRY_WAIT_MIN, GSPAY_RETRY_WAIT_MAX,
+//	GSPAY_CALLBACK_IP_WHITELIST (comma-separated), GSPAY_LANGUAGE, GSPAY_DEBUG,
+//	GSPAY_MAX_RESPONSE_SIZE, GSPAY_SERVER_TIMEZONE, GSPAY_MAX_CALLBACK_SIZE,
+//	GSPAY_STRICT_CALLBACKS
+//
+// Durations use [time.ParseDuration] syntax. Unset variables leave the
+// default. Values that cannot be parsed are all reported in the returned
+// error; pass the config to [NewFromConfig] to validate the rest.
+func ConfigFromEnv(prefix string) (Config, error) {
+	if prefix == "" {
+		prefix = DefaultEnvPrefix
+	}
+	prefix = strings.TrimSuffix(prefix, "_") + "_"
+
+	var (
+		cfg  Config
+		errs []error
+	)
+	lookup := func(name string) (string, string, bool) {
+		key := prefix + name
+		v, ok := os.LookupEnv(key)
+		return key, strings.TrimSpace(v), ok && strings.TrimSpace(v) != ""
+	}
+	invalid := func(key, value string) {
+		verr := errors.NewValidationErrorKey(i18n.English, key, i18n.MsgConfigInvalidValue, value)
+		verr.Err = errors.ErrInvalidConfig
+		errs = append(errs, verr)
+	}
+	duration := func(name string, dst *Duration) {
+		if key, v, ok := lookup(name); ok {
+			if err := dst.UnmarshalText([]byte(v)); err != nil {
+				invalid(key, v)
+			}
+		}
+	}
+
+	_, cfg.AuthKey, _ = lookup("AUTH_KEY")
+	_, cfg.SecretKey, _ = lookup("SECRET_KEY")
+	_, cfg.BaseURL, _ = lookup("BASE_URL")
+	duration("TIMEOUT", &cfg.Timeout)
+	duration("RETRY_WAIT_MIN", &cfg.RetryWaitMin)
+	duration("RETRY_WAIT_MAX", &cfg.RetryWaitMax)
+	if key, v, ok := lookup("RETRIES"); ok {
+		if n, err := strconv.Atoi(v); err != nil {
+			invalid(key, v)
+		} else {
+			cfg.Retries = &n
+		}
+	}
+	if _, v, ok := lookup("CALLBACK_IP_WHITELIST"); ok {
+		for entry := range strings.SplitSeq(v, ",") {
+			if entry = strings.TrimSpace(entry); entry != "" {
+				cfg.CallbackIPWhitelist = append(cfg.CallbackIPWhitelist, entry)
+			}
+		}
+	}
+	if _, v, ok := lookup("LANGUAGE"); ok {
+		cfg.Language = i18n.Language(strings.ToLower(v))
+	}
+	_, cfg.ServerTimezone, _ = lookup("SERVER_TIMEZONE")
+	if key, v, ok := lookup("MAX_RESPONSE_SIZE"); ok {
+		if n, err := strconv.ParseInt(v, 10, 64); err != nil {
+			invalid(key, v)
+		} else {
+			cfg.MaxResponseSize = n
+		}
+	}
+	if key, v, ok := lookup("MAX_CALLBACK_SIZE"); ok {
+		if n, err := strconv.ParseInt(v, 10, 64); err != nil {
+			invalid(key, v)
+		} else {
+			cfg.MaxCallbackSize = n
+		}
+	}
+	if key, v, ok := lookup("STRICT_CALLBACKS"); ok {
+		if b, err := strconv.ParseBool(v); err != nil {
+			invalid(key, v)
+		} else {
+			cfg.StrictCallbacks = b
+		}
+	}
+	if key, v, ok := lookup("DEBUG"); ok {
+		if b, err := strconv.ParseBool(v); err != nil {
+			invalid(key, v)
+		} else {
+			cfg.Debug = b
+		}
+	}
+
+	return cfg, errors.Join(errs...)
+}
@@ -0,0 +1,189 @@
+// Copyright 2026 H0llyW00dzZ
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/H0llyW00dzZ/gspay-go-sdk/src/errors"
+	"github.com/H0llyW00dzZ/gspay-go-sdk/src/i18n"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// validationFields returns the fields of every ValidationError joined in err.
+func validationFields(t *testing.T, err error) []string {
+	t.Helper()
+	joined, ok := err.(interface{ Unwrap() []error })
+	require.True(t, ok, "expected joined errors, got %T", err)
+	var fields []string
+	for _, e := range joined.Unwrap() {
+		verr := errors.GetValidationError(e)
+		require.NotNil(t, verr)
+		assert.ErrorIs(t, e, errors.ErrInvalidConfig)
+		fields = append(fields, verr.Field)
+	}
+	return fields
+}
+
+func TestDuration(t *testing.T) {
+	var cfg struct {
+		Timeout Duration `json:"timeout"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(`{"timeout": "1m30s"}`), &cfg))
+	assert.Equal(t, Duration(90*time.Second), cfg.Timeout)
+
+	out, err := json.Marshal(cfg)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"timeout": "1m30s"}`, string(out))
+
+	assert.Error(t, json.Unmarshal([]byte(`{"timeout": "soon"}`), &cfg))
+}
+
+func TestNewFromConfig(t *testing.T) {
+	t.Run("applies every setting", func(t *testing.T) {
+		var cfg Config
+		require.NoError(t, json.Unmarshal([]byte(`{
+			"auth_key": "auth",
+			"secret_key": "secret",
+			"base_url": "https://sandbox.example.com/",
+			"timeout": "10s",
+			"retries": 0,
+			"retry_wait_min": "1s",
+			"retry_wait_max": "4s",
+			"callback_ip_whitelist": ["203.0.113.0/24", "198.51.100.7"],
+			"language": "id",
+			"server_timezone": "UTC",
+			"debug": true
+		}`), &cfg))
+
+		c, err := NewFromConfig(cfg)
+		require.NoError(t, err)
+		assert.Equal(t, "auth", c.AuthKey)
+		assert.Equal(t, "https://sandbox.example.com", c.BaseURL)
+		assert.Equal(t, 10*time.Second, c.Timeout)
+		assert.Equal(t, 0, c.Retries)
+		assert.Equal(t, time.Second, c.RetryWaitMin)
+		assert.Equal(t, 4*time.Second, c.RetryWaitMax)
+		assert.True(t, c.IsIPWhitelisted("203.0.113.9"))
+		assert.False(t, c.IsIPWhitelisted("192.0.2.1"))
+		assert.Equal(t, i18n.Indonesian, c.Language)
+		assert.Equal(t, time.UTC, c.ServerLocation())
+		assert.True(t, c.Debug)
+	})
+
+	t.Run("zero values keep defaults", func(t *testing.T) {
+		c, err := NewFromConfig(Config{AuthKey: "auth", SecretKey: "secret"})
+		require.NoError(t, err)
+		defaults := New("auth", "secret")
+		assert.Equal(t, defaults.Timeout, c.Timeout)
+		assert.Equal(t, defaults.Retries, c.Retries)
+		assert.Equal(t, defaults.RetryWaitMin, c.RetryWaitMin)
+		assert.Equal(t, defaults.BaseURL, c.BaseURL)
+	})
+
+	t.Run("reports every invalid setting", func(t *testing.T) {
+		retries := -1
+		_, err := NewFromConfig(Config{
+			BaseURL:             "ftp://example.com",
+			Timeout:             Duration(time.Second),
+			Retries:             &retries,
+			RetryWaitMin:        Duration(5 * time.Second),
+			CallbackIPWhitelist: []string{"10.0.0.1", "not-an-ip"},
+			Language:            "fr",
+			ServerTimezone:      "Mars/Olympus",
+		})
+		require.Error(t, err)
+		assert.ErrorIs(t, err, errors.ErrInvalidConfig)
+		assert.Equal(t, []string{
+			"auth_key",
+			"secret_key",
+			"base_url",
+			"timeout",
+			"retries",
+			"retry_wait_max",
+			"callback_ip_whitelist[1]",
+			"language",
+			"server_timezone",
+		}, validationFields(t, err))
+		assert.Contains(t, err.Error(), `"not-an-ip"`)
+	})
+
+	t.Run("localizes messages", func(t *testing.T) {
+		err := Config{Language: i18n.Indonesian, SecretKey: "secret"}.Validate()
+		assert.Contains(t, err.Error(), "wajib diisi")
+	})
+
+	t.Run("keys may come from credentials", func(t *testing.T) {
+		c, err := NewFromConfig(Config{}, WithCredentials(StaticCredentials("auth", "secret")))
+		require.NoError(t, err)
+		resolved, err := c.Resolve(t.Context())
+		require.NoError(t, err)
+		assert.Equal(t, "auth", resolved.AuthKey)
+	})
+}
+
+func TestConfigFromEnv(t *testing.T) {
+	t.Run("reads prefixed variables", func(t *testing.T) {
+		t.Setenv("GSPAY_AUTH_KEY", "auth")
+		t.Setenv("GSPAY_SECRET_KEY", "secret")
+		t.Setenv("GSPAY_TIMEOUT", "15s")
+		t.Setenv("GSPAY_RETRIES", "5")
+		t.Setenv("GSPAY_RETRY_WAIT_MAX", "3s")
+		t.Setenv("GSPAY_CALLBACK_IP_WHITELIST", "10.0.0.1, 10.1.0.0/16,")
+		t.Setenv("GSPAY_LANGUAGE", "ID")
+		t.Setenv("GSPAY_DEBUG", "true")
+		t.Setenv("GSPAY_SERVER_TIMEZONE", "Asia/Jakarta")
+		t.Setenv("GSPAY_MAX_CALLBACK_SIZE", "16384")
+		t.Setenv("GSPAY_STRICT_CALLBACKS", "true")
+
+		cfg, err := ConfigFromEnv("")
+		require.NoError(t, err)
+		require.NotNil(t, cfg.Retries)
+		assert.Equal(t, 5, *cfg.Retries)
+		assert.Equal(t, Duration(15*time.Second), cfg.Timeout)
+		assert.Equal(t, Duration(3*time.Second), cfg.RetryWaitMax)
+		assert.Equal(t, []string{"10.0.0.1", "10.1.0.0/16"}, cfg.CallbackIPWhitelist)
+		assert.Equal(t, i18n.Indonesian, cfg.Language)
+		assert.True(t, cfg.Debug)
+		assert.Equal(t, "Asia/Jakarta", cfg.ServerTimezone)
+		assert.Equal(t, int64(16384), cfg.MaxCallbackSize)
+		assert.True(t, cfg.StrictCallbacks)
+
+		c, err := NewFromConfig(cfg)
+		require.NoError(t, err)
+		assert.Equal(t, 15*time.Second, c.Timeout)
+		assert.Equal(t, int64(16384), c.maxCallbackSize)
+		assert.True(t, c.strictCallbacks)
+	})
+
+	t.Run("custom prefix", func(t *testing.T) {
+		t.Setenv("BRAND_A_AUTH_KEY", "auth-a")
+		cfg, err := ConfigFromEnv("BRAND_A_")
+		require.NoError(t, err)
+		assert.Equal(t, "auth-a", cfg.AuthKey)
+	})
+
+	t.Run("reports every unparsable value", func(t *testing.T) {
+		t.Setenv("GSPAY_TIMEOUT", "30")
+		t.Setenv("GSPAY_RETRIES", "three")
+		t.Setenv("GSPAY_DEBUG", "maybe")
+
+		_, err := ConfigFromEnv("GSPAY")
+		assert.Equal(t, []string{"GSPAY_TIMEOUT", "GSPAY_RETRIES", "GSPAY_DEBUG"}, validationFields(t, err))
+	})
+}
@@ -0,0 +1,293 @@
+// Copyright 2026 H0llyW00dzZ
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"maps"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/H0llyW00dzZ/gspay-go-sdk/src/errors"
+	"github.com/H0llyW00dzZ/gspay-go-sdk/src/i18n"
+)
+
+// redacted replaces secret values in printed output.
+const redacted = "[REDACTED]"
+
+// Keys holds an operator's authentication and secret keys.
+type Keys struct {
+	// AuthKey is the operator authentication key (used in URL path).
+	AuthKey string `json:"auth_key"`
+	// SecretKey is the operator secret key (used for signature generation).
+	SecretKey string `json:"secret_key"`
+}
+
+// String redacts both keys so they never end up in logs.
+func (k Keys) String() string { return "{AuthKey:" + redacted + " SecretKey:" + redacted + "}" }
+
+// GoString redacts both keys for %#v formatting.
+func (k Keys) GoString() string {
+	return "client.Keys{AuthKey:" + `"` + redacted + `"` + ", SecretKey:" + `"` + redacted + `"}`
+}
+
+// Credentials supplies operator keys. It is consulted on every call, so
+// implementations can pick up changed keys without rebuilding the client.
+// Implementations must be safe for concurrent use.
+type Credentials interface {
+	// Retrieve returns the current keys.
+	Retrieve(ctx context.Context) (Keys, error)
+}
+
+// CredentialsFunc adapts a function to the [Credentials] interface.
+type CredentialsFunc func(ctx context.Context) (Keys, error)
+
+// Retrieve calls f(ctx).
+func (f CredentialsFunc) Retrieve(ctx context.Context) (Keys, error) { return f(ctx) }
+
+// WithCredentials sets the source of operator keys, resolved lazily per
+// call. The keys passed to [New] are ignored and may be empty.
+//
+// When a [SecretProvider] is also configured, it takes precedence for
+// signing and verification; the credentials then only supply the auth key.
+//
+// Example:
+//
+//	c := client.New("", "", client.WithCredentials(
+//	    client.EnvCredentials("GSPAY_AUTH_KEY", "GSPAY_SECRET_KEY"),
+//	))
+func WithCredentials(creds Credentials) Option {
+	return func(c *Client) {
+		c.credentials = creds
+	}
+}
+
+// Resolve returns a copy of the client with AuthKey and SecretKey filled in
+// from the configured [Credentials]. Without credentials the client itself is
+// returned. Service methods call this once per call.
+//
+// The auth key is always required. The secret key is required only when no
+// [SecretProvider] is configured.
+func (c *Client) Resolve(ctx context.Context) (*Client, error) {
+	if c.credentials == nil {
+		return c, nil
+	}
+	keys, err := c.credentials.Retrieve(ctx)
+	if srcErr := (*sourceError)(nil); errors.As(err, &srcErr) {
+		err = srcErr.localize(c.Language)
+	}
+	if errors.Is(err, errors.ErrCredentialsUnavailable) {
+		return nil, err
+	}
+	if err != nil {
+		return nil, c.Error(errors.ErrCredentialsUnavailable, err)
+	}
+	// A secret provider supplies the secret key, so only the auth key is needed.
+	if keys.AuthKey == "" || (keys.SecretKey == "" && c.secrets == nil) {
+		return nil, c.Error(errors.ErrCredentialsUnavailable)
+	}
+	resolved := *c
+	resolved.AuthKey = keys.AuthKey
+	resolved.SecretKey = keys.SecretKey
+	return &resolved, nil
+}
+
+// StaticCredentials returns [Credentials] that always supply the given keys.
+func StaticCredentials(authKey, secretKey string) Credentials {
+	keys := Keys{AuthKey: authKey, SecretKey: secretKey}
+	return CredentialsFunc(func(context.Context) (Keys, error) { return keys, nil })
+}
+
+// EnvCredentials returns [Credentials] read from environment variables on
+// every call. An unset or empty variable is an error.
+func EnvCredentials(authKeyVar, secretKeyVar string) Credentials {
+	return CredentialsFunc(func(context.Context) (Keys, error) {
+		keys := Keys{AuthKey: os.Getenv(authKeyVar), SecretKey: os.Getenv(secretKeyVar)}
+		if keys.AuthKey == "" {
+			return Keys{}, newSourceError(errors.ErrCredentialsUnavailable, authKeyVar)
+		}
+		if keys.SecretKey == "" {
+			return Keys{}, newSourceError(errors.ErrCredentialsUnavailable, secretKeyVar)
+		}
+		return keys, nil
+	})
+}
+
+// FileCredentials reads keys from a JSON file such as a mounted Kubernetes
+// secret:
+//
+//	{"auth_key": "...", "secret_key": "..."}
+//
+// The file is re-read whenever its modification time or size changes.
+type FileCredentials struct {
+	path string
+
+	mu      sync.Mutex
+	keys    Keys
+	modTime time.Time
+	size    int64
+}
+
+// NewFileCredentials creates [Credentials] backed by the JSON file at path.
+// The file is read on first use.
+func NewFileCredentials(path string) *FileCredentials {
+	return &FileCredentials{path: path}
+}
+
+// Retrieve returns the keys from the file, re-reading it if it changed.
+func (f *FileCredentials) Retrieve(context.Context) (Keys, error) {
+	info, err := os.Stat(f.path)
+	if err != nil {
+		return Keys{}, err
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.keys != (Keys{}) && info.ModTime().Equal(f.modTime) && info.Size() == f.size {
+		return f.keys, nil
+	}
+
+	data, err := os.ReadFile(f.path)
+	if err != nil {
+		return Keys{}, err
+	}
+	var keys Keys
+	if err := json.Unmarshal(data, &keys); err != nil {
+		return Keys{}, newSourceError(errors.ErrInvalidJSON, f.path, err)
+	}
+	f.keys, f.modTime, f.size = keys, info.ModTime(), info.Size()
+	return keys, nil
+}
+
+// SecretStore is the adapter interface for external secret managers such as
+// HashiCorp Vault, AWS Secrets Manager, or GCP Secret Manager. Implement it
+// with the vendor SDK of your choice.
+type SecretStore interface {
+	// GetSecret returns the key/value pairs stored at path.
+	GetSecret(ctx context.Context, path string) (map[string]string, error)
+}
+
+// StoreCredentials reads keys from a [SecretStore] and caches them for a TTL
+// so the store is not queried on every call.
+type StoreCredentials struct {
+	store SecretStore
+	path  string
+	ttl   time.Duration
+	now   func() time.Time
+
+	mu        sync.Mutex
+	keys      Keys
+	fetchedAt time.Time
+}
+
+// NewStoreCredentials creates [Credentials] that read the "auth_key" and
+// "secret_key" entries at path in store. A ttl of zero fetches on every call.
+//
+// Example:
+//
+//	creds := client.NewStoreCredentials(vaultAdapter, "secret/data/gspay", 5*time.Minute)
+//	c := client.New("", "", client.WithCredentials(creds))
+func NewStoreCredentials(store SecretStore, path string, ttl time.Duration) *StoreCredentials {
+	return &StoreCredentials{store: store, path: path, ttl: ttl, now: time.Now}
+}
+
+// Retrieve returns the cached keys, fetching them from the store when the
+// cache is empty or older than the TTL.
+func (s *StoreCredentials) Retrieve(ctx context.Context) (Keys, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	if s.keys != (Keys{}) && now.Sub(s.fetchedAt) < s.ttl {
+		return s.keys, nil
+	}
+
+	values, err := s.store.GetSecret(ctx, s.path)
+	if err != nil {
+		return Keys{}, err
+	}
+	keys := Keys{AuthKey: values["auth_key"], SecretKey: values["secret_key"]}
+	if keys.AuthKey == "" || keys.SecretKey == "" {
+		return Keys{}, newSourceError(errors.ErrCredentialsUnavailable, s.path)
+	}
+	s.keys, s.fetchedAt = keys, now
+	return keys, nil
+}
+
+// MemorySecretStore is an in-memory [SecretStore] for tests and local
+// development.
+type MemorySecretStore struct {
+	mu      sync.RWMutex
+	secrets map[string]map[string]string
+}
+
+// NewMemorySecretStore creates an empty [MemorySecretStore].
+func NewMemorySecretStore() *MemorySecretStore {
+	return &MemorySecretStore{secrets: make(map[string]map[string]string)}
+}
+
+// Put stores a copy of values at path, replacing any previous values.
+func (m *MemorySecretStore) Put(path string, values map[string]string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.secrets[path] = maps.Clone(values)
+}
+
+// GetSecret returns a copy of the values at path.
+func (m *MemorySecretStore) GetSecret(_ context.Context, path string) (map[string]string, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	values, ok := m.secrets[path]
+	if !ok {
+		return nil, newSourceError(errors.ErrCredentialsUnavailable, path)
+	}
+	return maps.Clone(values), nil
+}
+
+// sourceError is a failure of a built-in credential source. The source does
+// not know the client language, so [Client.Resolve] localizes it.
+type sourceError struct {
+	sentinel error
+	args     []any
+}
+
+// newSourceError returns a [sourceError] for sentinel with the given context and cause.
+func newSourceError(sentinel error, args ...any) error {
+	return &sourceError{sentinel: sentinel, args: args}
+}
+
+// Error returns the English message.
+func (e *sourceError) Error() string { return e.localize(i18n.English).Error() }
+
+// Unwrap returns the English error, which wraps the sentinel and the cause.
+func (e *sourceError) Unwrap() error { return e.localize(i18n.English) }
+
+// localize returns the error in lang.
+func (e *sourceError) localize(lang i18n.Language) error {
+	return errors.New(lang, e.sentinel, e.args...)
+}
+
+// String describes the client without exposing its keys, so printing a
+// client or a copy of it with %v or %+v is safe.
+func (c Client) String() string {
+	return "client.Client{BaseURL:" + c.BaseURL + " AuthKey:" + redacted + " SecretKey:" + redacted + "}"
+}
+
+// GoString is like [Client.String] for %#v formatting.
+func (c Client) GoString() string {
+	return c.String()
+}
@@ -0,0 +1,215 @@
+// Copyright 2026 H0llyW00dzZ
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/H0llyW00dzZ/gspay-go-sdk/src/errors"
+	"github.com/H0llyW00dzZ/gspay-go-sdk/src/i18n"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_Resolve(t *testing.T) {
+	t.Run("without credentials returns the client", func(t *testing.T) {
+		c := New("auth", "secret")
+		resolved, err := c.Resolve(t.Context())
+		require.NoError(t, err)
+		assert.Same(t, c, resolved)
+	})
+
+	t.Run("fills keys without modifying the client", func(t *testing.T) {
+		c := New("", "", WithCredentials(StaticCredentials("auth", "secret")))
+		resolved, err := c.Resolve(t.Context())
+		require.NoError(t, err)
+		assert.Equal(t, "auth", resolved.AuthKey)
+		assert.Equal(t, "secret", resolved.SigningSecret())
+		assert.Empty(t, c.AuthKey)
+		assert.Empty(t, c.SecretKey)
+	})
+
+	t.Run("wraps provider errors", func(t *testing.T) {
+		cause := fmt.Errorf("vault sealed")
+		c := New("", "", WithCredentials(CredentialsFunc(func(context.Context) (Keys, error) {
+			return Keys{}, cause
+		})))
+		_, err := c.Resolve(t.Context())
+		assert.ErrorIs(t, err, errors.ErrCredentialsUnavailable)
+		assert.ErrorIs(t, err, cause)
+	})
+
+	t.Run("localizes built-in source errors", func(t *testing.T) {
+		c := New("", "", WithLanguage(i18n.Indonesian),
+			WithCredentials(EnvCredentials("GSPAY_TEST_MISSING_AUTH", "GSPAY_TEST_MISSING_SECRET")))
+		_, err := c.Resolve(t.Context())
+		assert.ErrorIs(t, err, errors.ErrCredentialsUnavailable)
+		assert.Contains(t, err.Error(), "GSPAY_TEST_MISSING_AUTH")
+		assert.Contains(t, err.Error(), i18n.Get(i18n.Indonesian, errors.MsgCredentialsUnavailable))
+		assert.NotContains(t, err.Error(), i18n.Get(i18n.English, errors.MsgCredentialsUnavailable))
+	})
+
+	t.Run("rejects empty keys", func(t *testing.T) {
+		c := New("", "", WithCredentials(StaticCredentials("auth", "")))
+		_, err := c.Resolve(t.Context())
+		assert.ErrorIs(t, err, errors.ErrCredentialsUnavailable)
+
+		c = New("", "", WithCredentials(StaticCredentials("", "secret")))
+		_, err = c.Resolve(t.Context())
+		assert.ErrorIs(t, err, errors.ErrCredentialsUnavailable)
+	})
+
+	t.Run("secret provider only needs the auth key", func(t *testing.T) {
+		secrets := NewRotatingSecrets(Secret{ID: "v1", Value: "k1"})
+		c := New("", "", WithCredentials(StaticCredentials("auth", "")), WithSecretProvider(secrets))
+		resolved, err := c.Resolve(t.Context())
+		require.NoError(t, err)
+		assert.Equal(t, "auth", resolved.AuthKey)
+		assert.Equal(t, "k1", resolved.SigningSecret())
+	})
+
+	t.Run("verification resolves the secret key", func(t *testing.T) {
+		c := New("", "", WithCredentials(StaticCredentials("auth", "secret")))
+		_, ok, err := c.MatchSignature("data", c.GenerateSignature("datasecret"))
+		require.NoError(t, err)
+		assert.True(t, ok)
+	})
+
+	t.Run("verification fails when credentials are unavailable", func(t *testing.T) {
+		mock := &MockLogger{}
+		c := New("", "", WithLogger(mock), WithCredentials(EnvCredentials("GSPAY_TEST_MISSING_AUTH", "GSPAY_TEST_MISSING_SECRET")))
+		_, ok, err := c.MatchSignature("data", c.GenerateSignature("data"))
+		assert.ErrorIs(t, err, errors.ErrCredentialsUnavailable)
+		assert.NotErrorIs(t, err, errors.ErrInvalidSignature)
+		assert.False(t, ok)
+		assert.Len(t, mock.ErrorCalls, 1)
+	})
+}
+
+func TestEnvCredentials(t *testing.T) {
+	creds := EnvCredentials("GSPAY_TEST_AUTH", "GSPAY_TEST_SECRET")
+
+	t.Setenv("GSPAY_TEST_AUTH", "auth-1")
+	_, err := creds.Retrieve(t.Context())
+	assert.ErrorIs(t, err, errors.ErrCredentialsUnavailable)
+	assert.Contains(t, err.Error(), "GSPAY_TEST_SECRET")
+
+	t.Setenv("GSPAY_TEST_SECRET", "secret-1")
+	keys, err := creds.Retrieve(t.Context())
+	require.NoError(t, err)
+	assert.Equal(t, Keys{AuthKey: "auth-1", SecretKey: "secret-1"}, keys)
+
+	t.Setenv("GSPAY_TEST_AUTH", "auth-2")
+	keys, err = creds.Retrieve(t.Context())
+	require.NoError(t, err)
+	assert.Equal(t, "auth-2", keys.AuthKey, "variables are re-read on every call")
+}
+
+func TestFileCredentials(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "gspay.json")
+	write := func(content string, mtime time.Time) {
+		require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+		require.NoError(t, os.Chtimes(path, mtime, mtime))
+	}
+	creds := NewFileCredentials(path)
+
+	t.Run("missing file", func(t *testing.T) {
+		_, err := creds.Retrieve(t.Context())
+		assert.ErrorIs(t, err, os.ErrNotExist)
+	})
+
+	base := time.Now().Add(-time.Hour)
+	write(`{"auth_key": "auth-1", "secret_key": "secret-1"}`, base)
+
+	keys, err := creds.Retrieve(t.Context())
+	require.NoError(t, err)
+	assert.Equal(t, Keys{AuthKey: "auth-1", SecretKey: "secret-1"}, keys)
+
+	t.Run("re-reads when the file changes", func(t *testing.T) {
+		write(`{"auth_key": "auth-2", "secret_key": "secret-2"}`, base.Add(time.Minute))
+		keys, err := creds.Retrieve(t.Context())
+		require.NoError(t, err)
+		assert.Equal(t, "auth-2", keys.AuthKey)
+	})
+
+	t.Run("keeps cached keys while the file is unchanged", func(t *testing.T) {
+		// Same size and modification time: the cache is used.
+		write(`{"auth_key": "auth-3", "secret_key": "secret-3"}`, base.Add(time.Minute))
+		keys, err := creds.Retrieve(t.Context())
+		require.NoError(t, err)
+		assert.Equal(t, "auth-2", keys.AuthKey)
+	})
+
+	t.Run("invalid JSON", func(t *testing.T) {
+		write(`{not json`, base.Add(2*time.Minute))
+		_, err := creds.Retrieve(t.Context())
+		assert.ErrorIs(t, err, errors.ErrInvalidJSON)
+	})
+}
+
+func TestStoreCredentials(t *testing.T) {
+	store := NewMemorySecretStore()
+	store.Put("secret/gspay", map[string]string{"auth_key": "auth-1", "secret_key": "secret-1"})
+
+	now := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+	creds := NewStoreCredentials(store, "secret/gspay", time.Minute)
+	creds.now = func() time.Time { return now }
+
+	keys, err := creds.Retrieve(t.Context())
+	require.NoError(t, err)
+	assert.Equal(t, "auth-1", keys.AuthKey)
+
+	store.Put("secret/gspay", map[string]string{"auth_key": "auth-2", "secret_key": "secret-2"})
+	keys, err = creds.Retrieve(t.Context())
+	require.NoError(t, err)
+	assert.Equal(t, "auth-1", keys.AuthKey, "cached within the TTL")
+
+	now = now.Add(time.Minute)
+	keys, err = creds.Retrieve(t.Context())
+	require.NoError(t, err)
+	assert.Equal(t, "auth-2", keys.AuthKey, "refetched after the TTL")
+
+	t.Run("missing path", func(t *testing.T) {
+		_, err := NewStoreCredentials(store, "secret/other", 0).Retrieve(t.Context())
+		assert.ErrorIs(t, err, errors.ErrCredentialsUnavailable)
+	})
+
+	t.Run("missing entries", func(t *testing.T) {
+		store.Put("secret/partial", map[string]string{"auth_key": "auth"})
+		_, err := NewStoreCredentials(store, "secret/partial", 0).Retrieve(t.Context())
+		assert.ErrorIs(t, err, errors.ErrCredentialsUnavailable)
+	})
+}
+
+func TestClient_String(t *testing.T) {
+	c := New("my-auth-key", "my-secret-key", WithBaseURL("https://sandbox.example.com"))
+	keys := Keys{AuthKey: "my-auth-key", SecretKey: "my-secret-key"}
+
+	for _, format := range []string{"%v", "%+v", "%#v", "%s"} {
+		t.Run(format, func(t *testing.T) {
+			for _, out := range []string{fmt.Sprintf(format, c), fmt.Sprintf(format, *c), fmt.Sprintf(format, keys)} {
+				assert.NotContains(t, out, "my-auth-key")
+				assert.NotContains(t, out, "my-secret-key")
+				assert.Contains(t, out, redacted)
+			}
+		})
+	}
+	assert.Contains(t, c.String(), "https://sandbox.example.com")
+}
@@ -27,6 +27,9 @@
 //	    client.WithLanguage(i18n.Indonesian),
 //	)
 //
+// [New] ignores invalid option values for compatibility. Use [NewE] to have
+// every rejected value reported as one joined, localized error.
+//
 // # Configuration Options
 //
 // Available options:
@@ -40,13 +43,91 @@
 //   - [WithLogger]: Set custom structured logger
 //   - [WithDigest]: Set custom hash function for signatures (default: MD5)
 //   - [WithCallbackIPWhitelist]: Set allowed IPs for callback verification
+//   - [WithMaxResponseSize]: Cap response body size (default: 1 MiB)
+//   - [WithMaxCallbackSize]: Cap callback body size (default: 64 KiB)
+//   - [WithStrictCallbacks]: Reject unknown and duplicate keys in callbacks
+//   - [WithServerLocation]: Time zone of GSPAY2 dates (default: WIB, UTC+7)
 //   - [WithQRCodeOptions]: Configure QR code generation (size, recovery level, colors)
 //
+// # Configuration Files and Environment
+//
+// [NewFromConfig] builds a client from a serializable [Config], and
+// [ConfigFromEnv] reads one from GSPAY_* environment variables. Every invalid
+// setting is reported at once instead of being ignored:
+//
+//	cfg, err := client.ConfigFromEnv("GSPAY")
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	c, err := client.NewFromConfig(cfg, client.WithLogger(logger.Default()))
+//
 // # Retry Logic
 //
 // The client includes automatic retry with exponential backoff and jitter
 // for transient failures (5xx errors, timeouts, connection issues).
 //
+// # Per-Call Options
+//
+// Settings can be overridden for a single call with [CallOption] values,
+// accepted by [Client.DoRequest], [Client.Post], [Client.Get], and every
+// service method. The shared client is never modified:
+//
+//	status, err := paymentSvc.GetStatus(ctx, txID,
+//	    client.WithCallTimeout(2*time.Second),
+//	    client.WithCallRetries(0),
+//	    client.WithCallLanguage(i18n.Indonesian),
+//	    client.WithCorrelationID(requestID),
+//	)
+//
+// Available call options:
+//   - [WithCallTimeout]: Bound the whole call, including retries
+//   - [WithCallRetries]: Override the retry count
+//   - [WithCallRetryPolicy]: Decide which failures are retried
+//   - [WithCallLanguage]: Override the language for error and log messages
+//   - [WithCallHeader]: Send an extra HTTP header
+//   - [WithCorrelationID]: Add a correlation ID to every log entry
+//   - [WithResponseMeta]: Capture HTTP status, headers, body, attempts, and timing
+//
+// # Credentials
+//
+// Instead of passing keys to [New], a [Credentials] source can supply them
+// lazily on every call, so changed keys are picked up without a restart:
+//
+//	c := client.New("", "", client.WithCredentials(
+//	    client.NewFileCredentials("/var/run/secrets/gspay.json"),
+//	))
+//
+// Available credential sources:
+//   - [StaticCredentials]: Fixed keys
+//   - [EnvCredentials]: Environment variables, re-read on every call
+//   - [NewFileCredentials]: A JSON file, re-read when it changes
+//   - [NewStoreCredentials]: An external secret manager behind [SecretStore]
+//
+// [MemorySecretStore] is an in-memory [SecretStore] for tests. Printing a
+// [Client] or [Keys] with %v redacts the keys.
+//
+// # Secret Key Rotation
+//
+// [WithSecretProvider] replaces the fixed secret key with a [SecretProvider].
+// Requests are signed with the active key, and callbacks are accepted if they
+// match any key still accepted. [RotatingSecrets] can be swapped at runtime:
+//
+//	secrets := client.NewRotatingSecrets(client.Secret{ID: "2026-09", Value: oldKey})
+//	c := client.New("auth", oldKey, client.WithSecretProvider(secrets))
+//	secrets.Rotate(client.Secret{ID: "2026-10", Value: newKey}, 24*time.Hour)
+//
+// # Multiple Merchants
+//
+// A [Registry] holds one client per merchant account. Shared options are
+// given once and each [Merchant] supplies its own credentials:
+//
+//	reg, err := client.LoadRegistryFile("merchants.json", client.WithTimeout(30*time.Second))
+//	payments, err := client.ServiceFor(reg, "brand-a", payment.NewIDRService)
+//
+// [ServiceFor] caches one service per merchant and type. Callbacks can be
+// routed by URL path with [Registry.ClientForPath], or matched by signature
+// with [Registry.Identify] when every merchant shares the same endpoint.
+//
 // # Helper Functions
 //
 // Utility functions for common operations:
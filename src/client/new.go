@@ -21,6 +21,7 @@ import (
 
 	"github.com/H0llyW00dzZ/gspay-go-sdk/src/client/logger"
 	"github.com/H0llyW00dzZ/gspay-go-sdk/src/constants"
+	"github.com/H0llyW00dzZ/gspay-go-sdk/src/errors"
 	"github.com/H0llyW00dzZ/gspay-go-sdk/src/i18n"
 	"github.com/H0llyW00dzZ/gspay-go-sdk/src/internal/signature"
 )
@@ -30,7 +31,11 @@ type Client struct {
 	// AuthKey is the operator authentication key (used in URL path).
 	AuthKey string
 	// SecretKey is the operator secret key (used for signature generation).
+	// It is ignored when a [SecretProvider] is configured; see [WithSecretProvider].
 	SecretKey string
+	// credentials supplies AuthKey and SecretKey lazily per call.
+	// See [WithCredentials] for configuration.
+	credentials Credentials
 	// BaseURL is the API base URL.
 	BaseURL string
 	// HTTPClient is the underlying HTTP client.
@@ -71,6 +76,41 @@ type Client struct {
 	qrOpts []QROption
 	// qrCfg holds the resolved QR code configuration.
 	qrCfg *qrConfig
+	// callTimeout bounds a single call, including retries.
+	// See [WithCallTimeout] for configuration.
+	callTimeout time.Duration
+	// retryPolicy overrides the default retry decision.
+	// See [WithCallRetryPolicy] for configuration.
+	retryPolicy RetryPolicy
+	// headers are extra HTTP headers sent with each request.
+	// See [WithCallHeader] for configuration.
+	headers http.Header
+	// maxResponseSize caps the size of a response body in bytes.
+	// See [WithMaxResponseSize] for configuration.
+	maxResponseSize int64
+	// maxCallbackSize caps the size of a callback body in bytes.
+	// See [WithMaxCallbackSize] for configuration.
+	maxCallbackSize int64
+	// strictCallbacks rejects unknown and duplicate keys in callback bodies.
+	// See [WithStrictCallbacks] for configuration.
+	strictCallbacks bool
+	// callbackSourceIP is the source IP checked when verifying a raw callback.
+	// See [WithCallbackSourceIP] for configuration.
+	callbackSourceIP *string
+	// meta receives the HTTP details of a call.
+	// See [WithResponseMeta] for configuration.
+	meta *ResponseMeta
+	// optErrs collects values rejected by options; see [NewE].
+	optErrs []optionError
+	// secrets supplies rotating secret keys.
+	// See [WithSecretProvider] for configuration.
+	secrets SecretProvider
+	// businessErrors recognizes business failures in response bodies.
+	// See [WithBusinessCatalog] for configuration.
+	businessErrors *errors.BusinessCatalog
+	// serverLoc is the time zone of server dates without an offset.
+	// See [WithServerLocation] for configuration.
+	serverLoc *time.Location
 }
 
 // New creates a new GSPAY2 API client.
@@ -81,17 +121,21 @@ type Client struct {
 //   - opts: Optional configuration options (see [Option])
 func New(authKey, secretKey string, opts ...Option) *Client {
 	c := &Client{
-		AuthKey:      authKey,
-		SecretKey:    secretKey,
-		BaseURL:      constants.DefaultBaseURL,
-		Timeout:      time.Duration(constants.DefaultTimeout) * time.Second,
-		Retries:      constants.DefaultRetries,
-		RetryWaitMin: time.Duration(constants.DefaultRetryWaitMin) * time.Millisecond,
-		RetryWaitMax: time.Duration(constants.DefaultRetryWaitMax) * time.Millisecond,
-		Language:     i18n.English,
-		logger:       logger.Nop{},
-		digest:       nil, // nil by default; explicit assignment for clarity (uses MD5)
-		qrOpts:       nil, // nil by default; uses QR defaults (256px, Medium recovery)
+		AuthKey:         authKey,
+		SecretKey:       secretKey,
+		BaseURL:         constants.DefaultBaseURL,
+		Timeout:         time.Duration(constants.DefaultTimeout) * time.Second,
+		Retries:         constants.DefaultRetries,
+		RetryWaitMin:    time.Duration(constants.DefaultRetryWaitMin) * time.Millisecond,
+		RetryWaitMax:    time.Duration(constants.DefaultRetryWaitMax) * time.Millisecond,
+		Language:        i18n.English,
+		maxResponseSize: constants.DefaultMaxResponseSize,
+		maxCallbackSize: constants.DefaultMaxCallbackSize,
+		serverLoc:       constants.DefaultServerLocation,
+		businessErrors:  errors.DefaultBusinessCatalog,
+		logger:          logger.Nop{},
+		digest:          nil, // nil by default; explicit assignment for clarity (uses MD5)
+		qrOpts:          nil, // nil by default; uses QR defaults (256px, Medium recovery)
 	}
 
 	for _, opt := range opts {
@@ -112,3 +156,29 @@ func New(authKey, secretKey string, opts ...Option) *Client {
 
 	return c
 }
+
+// NewE is like [New] but reports invalid option values instead of silently
+// ignoring them, such as a timeout under 5 seconds, negative retries, an
+// unsupported language, a retry wait minimum above its maximum, a malformed
+// base URL, or a bad callback IP whitelist entry.
+//
+// All problems are returned at once as a joined error of
+// [errors.ValidationError] values, localized in the client's language and
+// matching [errors.ErrInvalidConfig].
+//
+// Example:
+//
+//	c, err := client.NewE("auth", "secret",
+//	    client.WithTimeout(2*time.Second), // rejected: below 5s
+//	    client.WithRetries(-1),            // rejected: negative
+//	)
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+func NewE(authKey, secretKey string, opts ...Option) (*Client, error) {
+	c := New(authKey, secretKey, opts...)
+	if err := joinOptionErrors(c.Language, c.optErrs); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
@@ -15,11 +15,16 @@
 package client
 
 import (
+	"fmt"
+	"net"
 	"net/http"
+	"net/url"
 	"strings"
 	"time"
 
 	"github.com/H0llyW00dzZ/gspay-go-sdk/src/client/logger"
+	"github.com/H0llyW00dzZ/gspay-go-sdk/src/constants"
+	"github.com/H0llyW00dzZ/gspay-go-sdk/src/errors"
 	"github.com/H0llyW00dzZ/gspay-go-sdk/src/i18n"
 	"github.com/H0llyW00dzZ/gspay-go-sdk/src/internal/signature"
 )
@@ -27,16 +32,45 @@ import (
 // Option is a functional option for configuring the [Client].
 type Option func(*Client)
 
+// optionError is an invalid value rejected by an [Option]. [New] ignores
+// these for compatibility; [NewE] reports them.
+type optionError struct {
+	field string
+	key   i18n.MessageKey
+	args  []any
+}
+
+// reject records an invalid option value.
+func (c *Client) reject(field string, key i18n.MessageKey, args ...any) {
+	c.optErrs = append(c.optErrs, optionError{field: field, key: key, args: args})
+}
+
+// joinOptionErrors localizes issues into one [errors.ValidationError] each,
+// joined into a single error matching [errors.ErrInvalidConfig].
+func joinOptionErrors(lang i18n.Language, issues []optionError) error {
+	errs := make([]error, 0, len(issues))
+	for _, issue := range issues {
+		verr := errors.NewValidationErrorKey(lang, issue.field, issue.key, issue.args...)
+		verr.Err = errors.ErrInvalidConfig
+		errs = append(errs, verr)
+	}
+	return errors.Join(errs...)
+}
+
 // WithBaseURL sets a custom base URL for the API.
 //
 // Trailing slashes are automatically trimmed from the URL.
-// Default is "https://api.thegspay.com".
+// Default is "https://api.thegspay.com". A URL that is not an absolute http
+// or https URL is rejected by [NewE].
 //
 // Example:
 //
 //	c := client.New("auth", "secret", client.WithBaseURL("https://sandbox.api.com"))
 func WithBaseURL(baseURL string) Option {
 	return func(c *Client) {
+		if u, err := url.Parse(baseURL); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			c.reject("base_url", i18n.MsgConfigInvalidURL)
+		}
 		c.BaseURL = strings.TrimRight(baseURL, "/")
 	}
 }
@@ -73,7 +107,8 @@ func WithHTTPClient(httpClient *http.Client) Option {
 // WithTimeout sets the request timeout.
 //
 // The timeout applies to each individual HTTP request.
-// Minimum allowed timeout is 5 seconds; values below this are ignored.
+// Minimum allowed timeout is 5 seconds; values below this are ignored by
+// [New] and reported by [NewE].
 // Default is 30 seconds. See [time.Duration] for duration formatting.
 //
 // Example:
@@ -81,16 +116,20 @@ func WithHTTPClient(httpClient *http.Client) Option {
 //	c := client.New("auth", "secret", client.WithTimeout(60*time.Second))
 func WithTimeout(timeout time.Duration) Option {
 	return func(c *Client) {
-		if timeout >= 5*time.Second {
-			c.Timeout = timeout
+		minTimeout := time.Duration(constants.MinTimeout) * time.Second
+		if timeout < minTimeout {
+			c.reject("timeout", i18n.MsgConfigTimeoutTooShort, minTimeout)
+			return
 		}
+		c.Timeout = timeout
 	}
 }
 
 // WithRetries sets the number of retry attempts for transient failures.
 //
 // Retries are attempted for 5xx server errors, timeouts, and connection issues.
-// Negative values are ignored. Set to 0 to disable retries.
+// Negative values are ignored by [New] and reported by [NewE].
+// Set to 0 to disable retries.
 // Default is 3 retries.
 //
 // Example:
@@ -98,12 +137,84 @@ func WithTimeout(timeout time.Duration) Option {
 //	c := client.New("auth", "secret", client.WithRetries(5))
 func WithRetries(retries int) Option {
 	return func(c *Client) {
-		if retries >= 0 {
-			c.Retries = retries
+		if retries < 0 {
+			c.reject("retries", i18n.MsgConfigNegative)
+			return
+		}
+		c.Retries = retries
+	}
+}
+
+// WithMaxResponseSize caps the size of a response body in bytes.
+//
+// Larger responses fail with [errors.ErrResponseTooLarge] and are not
+// retried. Default is 1 MiB. Values below 1 are ignored by [New] and
+// reported by [NewE].
+//
+// Example:
+//
+//	c := client.New("auth", "secret", client.WithMaxResponseSize(256<<10))
+func WithMaxResponseSize(n int64) Option {
+	return func(c *Client) {
+		if n < 1 {
+			c.reject("max_response_size", i18n.MsgConfigNotPositive)
+			return
+		}
+		c.maxResponseSize = n
+	}
+}
+
+// WithMaxCallbackSize caps the size of a callback body in bytes.
+//
+// Larger bodies are rejected by [Client.ReadCallback] and [Client.DecodeCallback]
+// with [errors.ErrCallbackTooLarge]. Default is 64 KiB. Values below 1 are
+// ignored by [New] and reported by [NewE].
+//
+// Example:
+//
+//	c := client.New("auth", "secret", client.WithMaxCallbackSize(16<<10))
+func WithMaxCallbackSize(n int64) Option {
+	return func(c *Client) {
+		if n < 1 {
+			c.reject("max_callback_size", i18n.MsgConfigNotPositive)
+			return
+		}
+		c.maxCallbackSize = n
+	}
+}
+
+// WithBusinessCatalog sets the catalog used to recognize business failures
+// reported in response bodies, so entries can be registered for one client
+// only. Default is [errors.DefaultBusinessCatalog]. A nil catalog is ignored.
+//
+// Example:
+//
+//	catalog := errors.NewBusinessCatalog()
+//	catalog.Register(errors.BusinessError{Code: 409, Err: errors.ErrDuplicateTransaction})
+//	c := client.New("auth", "secret", client.WithBusinessCatalog(catalog))
+func WithBusinessCatalog(catalog *errors.BusinessCatalog) Option {
+	return func(c *Client) {
+		if catalog != nil {
+			c.businessErrors = catalog
 		}
 	}
 }
 
+// WithStrictCallbacks makes [Client.DecodeCallback] reject callback bodies
+// with keys the callback type does not declare, or with a key repeated in the
+// same object ([errors.ErrDuplicateKey]). Both fail with [errors.ErrInvalidJSON].
+//
+// Default is false, so new fields added by GSPAY2 do not break verification.
+//
+// Example:
+//
+//	c := client.New("auth", "secret", client.WithStrictCallbacks(true))
+func WithStrictCallbacks(strict bool) Option {
+	return func(c *Client) {
+		c.strictCallbacks = strict
+	}
+}
+
 // WithDebug enables debug mode for the client.
 //
 // When enabled, sensitive data (auth keys, account numbers, account names) is shown
@@ -130,13 +241,25 @@ func WithDebug(debug bool) Option {
 //
 // The actual wait time is calculated using exponential backoff with jitter,
 // bounded between min and max values.
-// Default is 500ms minimum, 2s maximum.
+// Default is 500ms minimum, 2s maximum. [NewE] reports negative values
+// and a minimum greater than the maximum.
 //
 // Example:
 //
 //	c := client.New("auth", "secret", client.WithRetryWait(1*time.Second, 5*time.Second))
 func WithRetryWait(min, max time.Duration) Option {
 	return func(c *Client) {
+		switch {
+		case min < 0 || max < 0:
+			if min < 0 {
+				c.reject("retry_wait_min", i18n.MsgConfigNegative)
+			}
+			if max < 0 {
+				c.reject("retry_wait_max", i18n.MsgConfigNegative)
+			}
+		case max < min:
+			c.reject("retry_wait_max", i18n.MsgConfigRetryWaitOrder)
+		}
 		c.RetryWaitMin = min
 		c.RetryWaitMax = max
 	}
@@ -146,6 +269,7 @@ func WithRetryWait(min, max time.Duration) Option {
 //
 // Accepts individual IP addresses (e.g., "192.168.1.1") or CIDR notation (e.g., "192.168.1.0/24").
 // If the whitelist is empty, IP validation is skipped during callback verification.
+// Entries that are neither are skipped by [New] and reported by [NewE].
 //
 // Example:
 //
@@ -158,6 +282,11 @@ func WithCallbackIPWhitelist(ips ...string) Option {
 	return func(c *Client) {
 		c.CallbackIPWhitelist = ips
 		c.parseIPWhitelist()
+		for i, entry := range ips {
+			if _, _, err := net.ParseCIDR(entry); err != nil && net.ParseIP(entry) == nil {
+				c.reject(fmt.Sprintf("callback_ip_whitelist[%d]", i), i18n.MsgConfigInvalidIP, entry)
+			}
+		}
 	}
 }
 
@@ -169,17 +298,59 @@ func WithCallbackIPWhitelist(ips ...string) Option {
 //   - [i18n.English] - English (default)
 //   - [i18n.Indonesian] - Indonesian (Bahasa Indonesia)
 //
+// Unsupported languages are ignored by [New] and reported by [NewE].
+//
 // Example:
 //
 //	client.New("auth", "secret", client.WithLanguage(i18n.Indonesian))
 func WithLanguage(lang i18n.Language) Option {
 	return func(c *Client) {
-		if lang.IsValid() {
-			c.Language = lang
+		if !lang.IsValid() {
+			c.reject("language", i18n.MsgConfigUnsupportedLanguage, string(lang))
+			return
 		}
+		c.Language = lang
 	}
 }
 
+// WithServerLocation sets the time zone used to interpret GSPAY2 dates that
+// carry no offset, such as the expire date of a payment.
+//
+// Default is [constants.DefaultServerLocation] (WIB, UTC+7). A nil location
+// is ignored by [New] and reported by [NewE].
+//
+// Example:
+//
+//	loc, _ := time.LoadLocation("Asia/Jakarta")
+//	client.New("auth", "secret", client.WithServerLocation(loc))
+func WithServerLocation(loc *time.Location) Option {
+	return func(c *Client) {
+		if loc == nil {
+			c.reject("server_location", i18n.MsgConfigRequired)
+			return
+		}
+		c.serverLoc = loc
+	}
+}
+
+// withServerTimezone loads the named time zone for [Config].
+func withServerTimezone(name string) Option {
+	return func(c *Client) {
+		loc, err := time.LoadLocation(name)
+		if err != nil {
+			c.reject("server_timezone", i18n.MsgConfigInvalidValue, name)
+			return
+		}
+		c.serverLoc = loc
+	}
+}
+
+// ServerLocation returns the time zone used to interpret GSPAY2 dates.
+// See [WithServerLocation].
+func (c *Client) ServerLocation() *time.Location {
+	return c.serverLoc
+}
+
 // WithLogger sets a custom [logger.Handler] for the client.
 //
 // If l is nil, a [logger.Nop] is used (no logging).
@@ -0,0 +1,267 @@
+// Copyright 2026 H0llyW00dzZ
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"encoding/json"
+	"io"
+	"maps"
+	"os"
+	"reflect"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/H0llyW00dzZ/gspay-go-sdk/src/client/logger"
+	"github.com/H0llyW00dzZ/gspay-go-sdk/src/errors"
+)
+
+// Merchant holds the credentials of a single GSPAY2 operator account.
+type Merchant struct {
+	// ID is the merchant identifier used as the registry key (e.g., "brand-a-id").
+	ID string `json:"id"`
+	// AuthKey is the operator authentication key.
+	AuthKey string `json:"auth_key"`
+	// SecretKey is the operator secret key.
+	SecretKey string `json:"secret_key"`
+	// BaseURL overrides the API base URL for this merchant (optional).
+	BaseURL string `json:"base_url,omitempty"`
+	// CallbackIPWhitelist overrides the callback IP whitelist for this merchant (optional).
+	CallbackIPWhitelist []string `json:"callback_ip_whitelist,omitempty"`
+}
+
+// RegistryConfig is the configuration source format read by [LoadRegistry].
+//
+// Example JSON:
+//
+//	{
+//	  "merchants": [
+//	    {"id": "brand-a", "auth_key": "...", "secret_key": "..."},
+//	    {"id": "brand-b", "auth_key": "...", "secret_key": "...", "base_url": "https://sandbox.example.com"}
+//	  ]
+//	}
+type RegistryConfig struct {
+	// Merchants lists the merchant accounts to register.
+	Merchants []Merchant `json:"merchants"`
+}
+
+// Registry holds per-merchant clients and services keyed by merchant ID.
+//
+// A Registry is safe for concurrent use.
+type Registry struct {
+	// base carries the shared options and is used for registry errors.
+	base *Client
+	opts []Option
+
+	mu       sync.RWMutex
+	clients  map[string]*Client
+	services map[serviceKey]any
+}
+
+// serviceKey identifies a cached service of a given type for a merchant.
+type serviceKey struct {
+	id  string
+	typ reflect.Type
+}
+
+// NewRegistry creates an empty [Registry].
+//
+// The options are applied to every merchant client, before any
+// merchant-specific settings.
+//
+// Example:
+//
+//	reg := client.NewRegistry(client.WithTimeout(10 * time.Second))
+//	if err := reg.Register(client.Merchant{ID: "brand-a", AuthKey: "...", SecretKey: "..."}); err != nil {
+//	    log.Fatal(err)
+//	}
+func NewRegistry(opts ...Option) *Registry {
+	return &Registry{
+		base:     New("", "", opts...),
+		opts:     opts,
+		clients:  make(map[string]*Client),
+		services: make(map[serviceKey]any),
+	}
+}
+
+// LoadRegistry creates a [Registry] from a JSON [RegistryConfig] read from r.
+func LoadRegistry(r io.Reader, opts ...Option) (*Registry, error) {
+	reg := NewRegistry(opts...)
+
+	var cfg RegistryConfig
+	if err := json.NewDecoder(r).Decode(&cfg); err != nil {
+		return nil, reg.base.Error(errors.ErrInvalidJSON, err)
+	}
+	for _, m := range cfg.Merchants {
+		if err := reg.Register(m); err != nil {
+			return nil, err
+		}
+	}
+	return reg, nil
+}
+
+// LoadRegistryFile creates a [Registry] from a JSON [RegistryConfig] file.
+func LoadRegistryFile(path string, opts ...Option) (*Registry, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return LoadRegistry(f, opts...)
+}
+
+// Register adds a merchant to the registry.
+//
+// Additional options are applied after the registry options and the
+// merchant's BaseURL and CallbackIPWhitelist.
+// Returns an error wrapping [errors.ErrDuplicateMerchant] if the ID is already
+// registered, or [errors.ErrInvalidMerchantConfig] if a field is missing.
+func (r *Registry) Register(m Merchant, opts ...Option) error {
+	switch {
+	case m.ID == "":
+		return r.base.Error(errors.ErrInvalidMerchantConfig, "id")
+	case m.AuthKey == "":
+		return r.base.Error(errors.ErrInvalidMerchantConfig, m.ID+": auth_key")
+	case m.SecretKey == "":
+		return r.base.Error(errors.ErrInvalidMerchantConfig, m.ID+": secret_key")
+	}
+
+	all := slices.Clone(r.opts)
+	if m.BaseURL != "" {
+		all = append(all, WithBaseURL(m.BaseURL))
+	}
+	if len(m.CallbackIPWhitelist) > 0 {
+		all = append(all, WithCallbackIPWhitelist(m.CallbackIPWhitelist...))
+	}
+	all = append(all, opts...)
+	c := New(m.AuthKey, m.SecretKey, all...)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.clients[m.ID]; ok {
+		return r.base.Error(errors.ErrDuplicateMerchant, m.ID)
+	}
+	r.clients[m.ID] = c
+	return nil
+}
+
+// Client returns the client of the merchant with the given ID.
+// Returns an error wrapping [errors.ErrUnknownMerchant] if the ID is not registered.
+func (r *Registry) Client(id string) (*Client, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	c, ok := r.clients[id]
+	if !ok {
+		return nil, r.base.Error(errors.ErrUnknownMerchant, id)
+	}
+	return c, nil
+}
+
+// Merchants returns the registered merchant IDs in sorted order.
+func (r *Registry) Merchants() []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return slices.Sorted(maps.Keys(r.clients))
+}
+
+// ServiceFor returns the service of type T for the merchant with the given ID,
+// creating it with newService on first use.
+//
+// Services are cached per merchant and type, so the same instance is returned
+// on subsequent calls.
+//
+// Example:
+//
+//	svc, err := client.ServiceFor(reg, "brand-a", payment.NewIDRService)
+//	if err != nil {
+//	    return err
+//	}
+//	resp, err := svc.Create(ctx, req)
+func ServiceFor[T any](r *Registry, id string, newService func(*Client) T) (T, error) {
+	var zero T
+	key := serviceKey{id: id, typ: reflect.TypeFor[T]()}
+
+	r.mu.RLock()
+	svc, ok := r.services[key]
+	r.mu.RUnlock()
+	if ok {
+		return svc.(T), nil
+	}
+
+	c, err := r.Client(id)
+	if err != nil {
+		return zero, err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if svc, ok := r.services[key]; ok {
+		return svc.(T), nil
+	}
+	created := newService(c)
+	r.services[key] = created
+	return created, nil
+}
+
+// ClientForPath resolves the merchant from a callback routing path.
+//
+// The merchant ID is the first path segment after prefix. For example, with
+// prefix "/callbacks/", the path "/callbacks/brand-a/idr" resolves to "brand-a".
+// Returns an error wrapping [errors.ErrUnknownMerchant] if no merchant matches.
+func (r *Registry) ClientForPath(path, prefix string) (string, *Client, error) {
+	rest, ok := strings.CutPrefix(path, prefix)
+	if !ok {
+		return "", nil, r.base.Error(errors.ErrUnknownMerchant, path)
+	}
+	id, _, _ := strings.Cut(strings.TrimPrefix(rest, "/"), "/")
+	c, err := r.Client(id)
+	if err != nil {
+		return "", nil, err
+	}
+	return id, c, nil
+}
+
+// Identify finds the merchant whose credentials verify a callback.
+//
+// verify is called once for every registered merchant, in sorted ID order,
+// with a client whose logging is disabled so mismatches are not logged as
+// failures. All merchants are always tried, so the time taken does not reveal
+// which merchant matched. The first merchant for which verify returns nil is
+// returned, together with its regular client.
+//
+// Returns an error wrapping [errors.ErrNoMatchingMerchant] if no merchant matches.
+//
+// Example:
+//
+//	id, c, err := reg.Identify(func(c *client.Client) error {
+//	    return payment.NewIDRService(c).VerifyCallback(&callback)
+//	})
+func (r *Registry) Identify(verify func(*Client) error) (string, *Client, error) {
+	r.mu.RLock()
+	clients := maps.Clone(r.clients)
+	r.mu.RUnlock()
+
+	matched := ""
+	for _, id := range slices.Sorted(maps.Keys(clients)) {
+		err := verify(clients[id].WithCallOptions(withLogger(logger.Nop{})))
+		if err == nil && matched == "" {
+			matched = id
+		}
+	}
+	if matched == "" {
+		return "", nil, r.base.Error(errors.ErrNoMatchingMerchant)
+	}
+	return matched, clients[matched], nil
+}
@@ -0,0 +1,219 @@
+// Copyright 2026 H0llyW00dzZ
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/H0llyW00dzZ/gspay-go-sdk/src/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeService stands in for a payment or payout service.
+type fakeService struct{ c *Client }
+
+func newFakeService(c *Client) *fakeService { return &fakeService{c: c} }
+
+func newTestRegistry(t *testing.T) *Registry {
+	t.Helper()
+	reg := NewRegistry(WithRetries(1))
+	require.NoError(t, reg.Register(Merchant{ID: "brand-a", AuthKey: "auth-a", SecretKey: "secret-a"}))
+	require.NoError(t, reg.Register(Merchant{
+		ID:                  "brand-b",
+		AuthKey:             "auth-b",
+		SecretKey:           "secret-b",
+		BaseURL:             "https://sandbox.example.com/",
+		CallbackIPWhitelist: []string{"10.0.0.1"},
+	}))
+	return reg
+}
+
+func TestRegistry_Register(t *testing.T) {
+	reg := newTestRegistry(t)
+	assert.Equal(t, []string{"brand-a", "brand-b"}, reg.Merchants())
+
+	t.Run("applies shared and merchant settings", func(t *testing.T) {
+		c, err := reg.Client("brand-b")
+		require.NoError(t, err)
+		assert.Equal(t, "auth-b", c.AuthKey)
+		assert.Equal(t, "secret-b", c.SecretKey)
+		assert.Equal(t, 1, c.Retries)
+		assert.Equal(t, "https://sandbox.example.com", c.BaseURL)
+		assert.Equal(t, []string{"10.0.0.1"}, c.CallbackIPWhitelist)
+	})
+
+	t.Run("rejects duplicates and incomplete merchants", func(t *testing.T) {
+		tests := []struct {
+			name     string
+			merchant Merchant
+			want     error
+		}{
+			{"duplicate", Merchant{ID: "brand-a", AuthKey: "x", SecretKey: "y"}, errors.ErrDuplicateMerchant},
+			{"missing id", Merchant{AuthKey: "x", SecretKey: "y"}, errors.ErrInvalidMerchantConfig},
+			{"missing auth key", Merchant{ID: "c", SecretKey: "y"}, errors.ErrInvalidMerchantConfig},
+			{"missing secret key", Merchant{ID: "c", AuthKey: "x"}, errors.ErrInvalidMerchantConfig},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				assert.ErrorIs(t, reg.Register(tt.merchant), tt.want)
+			})
+		}
+	})
+
+	t.Run("unknown merchant", func(t *testing.T) {
+		_, err := reg.Client("brand-z")
+		assert.ErrorIs(t, err, errors.ErrUnknownMerchant)
+		assert.Contains(t, err.Error(), "brand-z")
+	})
+}
+
+func TestServiceFor(t *testing.T) {
+	reg := newTestRegistry(t)
+
+	svc, err := ServiceFor(reg, "brand-a", newFakeService)
+	require.NoError(t, err)
+	assert.Equal(t, "auth-a", svc.c.AuthKey)
+
+	again, err := ServiceFor(reg, "brand-a", newFakeService)
+	require.NoError(t, err)
+	assert.Same(t, svc, again)
+
+	other, err := ServiceFor(reg, "brand-b", newFakeService)
+	require.NoError(t, err)
+	assert.NotSame(t, svc, other)
+
+	_, err = ServiceFor(reg, "brand-z", newFakeService)
+	assert.ErrorIs(t, err, errors.ErrUnknownMerchant)
+
+	t.Run("concurrent access creates one instance", func(t *testing.T) {
+		var created sync.Map
+		var wg sync.WaitGroup
+		for range 10 {
+			wg.Go(func() {
+				s, err := ServiceFor(reg, "brand-b", func(c *Client) *Client { return c })
+				assert.NoError(t, err)
+				created.Store(s, true)
+			})
+		}
+		wg.Wait()
+		n := 0
+		created.Range(func(any, any) bool { n++; return true })
+		assert.Equal(t, 1, n)
+	})
+}
+
+func TestRegistry_ClientForPath(t *testing.T) {
+	reg := newTestRegistry(t)
+
+	tests := []struct {
+		name   string
+		path   string
+		wantID string
+	}{
+		{"merchant segment", "/callbacks/brand-a/idr", "brand-a"},
+		{"merchant only", "/callbacks/brand-b", "brand-b"},
+		{"unknown merchant", "/callbacks/brand-z/idr", ""},
+		{"wrong prefix", "/other/brand-a", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			id, c, err := reg.ClientForPath(tt.path, "/callbacks/")
+			if tt.wantID == "" {
+				assert.ErrorIs(t, err, errors.ErrUnknownMerchant)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantID, id)
+			assert.Equal(t, "auth-"+strings.TrimPrefix(tt.wantID, "brand-"), c.AuthKey)
+		})
+	}
+}
+
+func TestRegistry_Identify(t *testing.T) {
+	mock := &MockLogger{}
+	reg := NewRegistry(WithLogger(mock))
+	for _, id := range []string{"brand-a", "brand-b", "brand-c"} {
+		require.NoError(t, reg.Register(Merchant{ID: id, AuthKey: "auth-" + id, SecretKey: "secret-" + id}))
+	}
+
+	// A callback signed with brand-b's secret.
+	payload := "123" + "50000.00" + "TXN123456789" + "1"
+	sig := New("", "secret-brand-b").GenerateSignature(payload + "secret-brand-b")
+
+	var tried []string
+	verify := func(c *Client) error {
+		tried = append(tried, c.AuthKey)
+		c.Logger().Warn("should not be logged")
+		if !c.VerifySignature(c.GenerateSignature(payload+c.SecretKey), sig) {
+			return c.Error(errors.ErrInvalidSignature)
+		}
+		return nil
+	}
+
+	id, c, err := reg.Identify(verify)
+	require.NoError(t, err)
+	assert.Equal(t, "brand-b", id)
+	assert.Equal(t, "auth-brand-b", c.AuthKey)
+	assert.Equal(t, []string{"auth-brand-a", "auth-brand-b", "auth-brand-c"}, tried, "all merchants are tried")
+	assert.Empty(t, mock.WarnCalls)
+	assert.Same(t, mock, c.Logger(), "returned client keeps its logger")
+
+	t.Run("no match", func(t *testing.T) {
+		sig = "invalid"
+		_, _, err := reg.Identify(verify)
+		assert.ErrorIs(t, err, errors.ErrNoMatchingMerchant)
+	})
+}
+
+func TestLoadRegistry(t *testing.T) {
+	t.Run("loads merchants from JSON", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "merchants.json")
+		require.NoError(t, os.WriteFile(path, []byte(`{
+			"merchants": [
+				{"id": "brand-a", "auth_key": "auth-a", "secret_key": "secret-a"},
+				{"id": "brand-b", "auth_key": "auth-b", "secret_key": "secret-b", "base_url": "https://sandbox.example.com"}
+			]
+		}`), 0o600))
+
+		reg, err := LoadRegistryFile(path, WithRetries(0))
+		require.NoError(t, err)
+		assert.Equal(t, []string{"brand-a", "brand-b"}, reg.Merchants())
+
+		c, err := reg.Client("brand-b")
+		require.NoError(t, err)
+		assert.Equal(t, "https://sandbox.example.com", c.BaseURL)
+		assert.Equal(t, 0, c.Retries)
+	})
+
+	t.Run("rejects invalid JSON", func(t *testing.T) {
+		_, err := LoadRegistry(strings.NewReader("{not json"))
+		assert.ErrorIs(t, err, errors.ErrInvalidJSON)
+	})
+
+	t.Run("rejects invalid merchants", func(t *testing.T) {
+		_, err := LoadRegistry(strings.NewReader(`{"merchants":[{"id":"a","auth_key":"x"}]}`))
+		assert.ErrorIs(t, err, errors.ErrInvalidMerchantConfig)
+	})
+
+	t.Run("missing file", func(t *testing.T) {
+		_, err := LoadRegistryFile(filepath.Join(t.TempDir(), "missing.json"))
+		assert.ErrorIs(t, err, os.ErrNotExist)
+	})
+}
@@ -106,6 +106,7 @@ func parseRetryAfter(value string) time.Duration {
 // responseResult holds the result of processing an HTTP response.
 type responseResult struct {
 	Response   *Response
+	StatusCode int // HTTP status code (0 if no response was received)
 	Retry      bool
 	RetryAfter time.Duration // Server-suggested wait time from Retry-After header (0 means use manual backoff)
 	Err        error
@@ -121,7 +122,10 @@ func (c *Client) prepareRequestBody(body any) (io.Reader, gc.Buffer, func(), err
 	if err := json.NewEncoder(buf).Encode(body); err != nil {
 		buf.Reset()
 		gc.Default.Put(buf)
-		return nil, nil, func() {}, errors.New(c.Language, errors.ErrInvalidJSON, err)
+		return nil, nil, func() {}, errors.WithClassification(
+			errors.New(c.Language, errors.ErrInvalidJSON, err),
+			errors.Classification{Category: errors.CategoryValidation},
+		)
 	}
 
 	reqBody := bytes.NewReader(buf.Bytes())
@@ -137,7 +141,10 @@ func (c *Client) prepareRequestBody(body any) (io.Reader, gc.Buffer, func(), err
 func (c *Client) createHTTPRequest(ctx context.Context, method, fullURL string, reqBody io.Reader, hasBody bool) (*http.Request, error) {
 	req, err := http.NewRequestWithContext(ctx, method, fullURL, reqBody)
 	if err != nil {
-		return nil, errors.New(c.Language, errors.ErrRequestFailed, err)
+		return nil, errors.WithClassification(
+			errors.New(c.Language, errors.ErrRequestFailed, err),
+			errors.Classification{Category: errors.CategoryValidation},
+		)
 	}
 
 	req.Header.Set("Accept", "application/json")
@@ -145,6 +152,9 @@ func (c *Client) createHTTPRequest(ctx context.Context, method, fullURL string,
 	if hasBody {
 		req.Header.Set("Content-Type", "application/json")
 	}
+	for key, values := range c.headers {
+		req.Header[key] = values
+	}
 
 	return req, nil
 }
@@ -154,12 +164,26 @@ func (c *Client) processResponse(resp *http.Response, endpoint string) responseR
 	defer resp.Body.Close()
 
 	respBuf := gc.Default.Get()
-	_, err := respBuf.ReadFrom(resp.Body)
+	_, err := respBuf.ReadFrom(io.LimitReader(resp.Body, c.maxResponseSize+1))
 
 	if err != nil {
 		respBuf.Reset()
 		gc.Default.Put(respBuf)
-		return responseResult{Retry: true, Err: errors.New(c.Language, errors.ErrRequestFailed, err)}
+		return responseResult{Retry: true, Err: errors.WithClassification(
+			errors.New(c.Language, errors.ErrRequestFailed, err),
+			errors.ClassifyTransport(err),
+		)}
+	}
+
+	c.recordResponse(resp, respBuf.Bytes())
+
+	if int64(respBuf.Len()) > c.maxResponseSize {
+		respBuf.Reset()
+		gc.Default.Put(respBuf)
+		return responseResult{Err: errors.WithClassification(
+			errors.New(c.Language, errors.ErrResponseTooLarge, strconv.FormatInt(c.maxResponseSize, 10)+" bytes"),
+			errors.Classification{Category: errors.CategoryServer, MayHaveReachedServer: true},
+		)}
 	}
 
 	// Handle HTTP errors - retry on server errors (5xx), 404, or 429
@@ -175,7 +199,8 @@ func (c *Client) processResponse(resp *http.Response, endpoint string) responseR
 		// Note: 404 is included because the GSPAY API may transiently return 404
 		// during service deployments or load balancer routing changes.
 		// 429 indicates rate limiting - retry with backoff.
-		retry := (resp.StatusCode >= 500 || resp.StatusCode == 404 || resp.StatusCode == 429)
+		class := errors.ClassifyStatus(resp.StatusCode)
+		retry := class.Retryable
 
 		// Log error
 		c.logger.Error(c.I18n(i18n.LogHTTPErrorResponse),
@@ -193,18 +218,21 @@ func (c *Client) processResponse(resp *http.Response, endpoint string) responseR
 			return responseResult{
 				Retry:      retry,
 				RetryAfter: retryAfter,
-				Err:        errors.New(c.Language, errors.ErrRateLimited),
+				Err:        errors.WithClassification(errors.New(c.Language, errors.ErrRateLimited), class),
 			}
 		}
 
-		return responseResult{Retry: retry, Err: apiErr}
+		return responseResult{Retry: retry, Err: errors.WithClassification(apiErr, class)}
 	}
 
 	// Handle empty response
 	if respBuf.Len() == 0 {
 		respBuf.Reset()
 		gc.Default.Put(respBuf)
-		return responseResult{Retry: true, Err: errors.New(c.Language, errors.ErrEmptyResponse)}
+		return responseResult{Retry: true, Err: errors.WithClassification(
+			errors.New(c.Language, errors.ErrEmptyResponse),
+			errors.Classification{Category: errors.CategoryServer, Retryable: true, MayHaveReachedServer: true},
+		)}
 	}
 
 	// Parse response
@@ -212,7 +240,10 @@ func (c *Client) processResponse(resp *http.Response, endpoint string) responseR
 	if err := json.Unmarshal(respBuf.Bytes(), &apiResp); err != nil {
 		respBuf.Reset()
 		gc.Default.Put(respBuf)
-		return responseResult{Err: errors.New(c.Language, errors.ErrInvalidJSON, err)}
+		return responseResult{Err: errors.WithClassification(
+			errors.New(c.Language, errors.ErrInvalidJSON, err),
+			errors.Classification{Category: errors.CategoryServer, MayHaveReachedServer: true},
+		)}
 	}
 
 	// Debug logging
@@ -224,16 +255,10 @@ func (c *Client) processResponse(resp *http.Response, endpoint string) responseR
 
 	// Check for API-level errors
 	if !apiResp.IsSuccess() {
-		apiErr := &errors.APIError{
-			Code:        apiResp.Code,
-			Message:     apiResp.Message,
-			Endpoint:    endpoint,
-			RawResponse: string(respBuf.Bytes()),
-			Lang:        c.Language,
-		}
+		apiErr := c.businessErrors.NewError(c.Language, apiResp.Code, apiResp.Message, endpoint, string(respBuf.Bytes()))
 		respBuf.Reset()
 		gc.Default.Put(respBuf)
-		return responseResult{Err: apiErr}
+		return responseResult{Err: errors.WithClassification(apiErr, errors.ClassifyBusiness(apiErr.Code, apiErr.Err))}
 	}
 
 	// Clean up buffer
@@ -283,10 +308,14 @@ func (c *Client) performRequest(ctx context.Context, params requestParams) respo
 			"error", err.Error(),
 		)
 		// Retry on transient network errors
-		return responseResult{Retry: true, Err: errors.New(c.Language, errors.ErrRequestFailed, err)}
+		return responseResult{Retry: true, Err: errors.WithClassification(
+			errors.New(c.Language, errors.ErrRequestFailed, err),
+			errors.ClassifyTransport(err),
+		)}
 	}
 
 	result := c.processResponse(resp, params.Endpoint)
+	result.StatusCode = resp.StatusCode
 	if result.Err != nil {
 		return result
 	}
@@ -302,6 +331,9 @@ func (c *Client) performRequest(ctx context.Context, params requestParams) respo
 
 // executeWithRetry executes the HTTP request with retry logic.
 func (c *Client) executeWithRetry(ctx context.Context, params retryParams) (*Response, error) {
+	c.startMeta(params.Method, params.Endpoint)
+	defer c.finishMeta(time.Now())
+
 	var lastErr error
 	var actualAttempts int
 	var suggestedWait time.Duration // Server-suggested wait time from Retry-After header
@@ -333,6 +365,7 @@ func (c *Client) executeWithRetry(ctx context.Context, params retryParams) (*Res
 		// Update attempt number and call performRequest
 		params.Attempt = attempt
 		result := c.performRequest(ctx, params.requestParams)
+		c.recordAttempt(result.Err)
 		if result.Err == nil {
 			return result.Response, nil
 		}
@@ -340,7 +373,12 @@ func (c *Client) executeWithRetry(ctx context.Context, params retryParams) (*Res
 		lastErr = result.Err
 		suggestedWait = result.RetryAfter
 
-		if result.Retry && attempt < c.Retries {
+		retry := result.Retry
+		if c.retryPolicy != nil {
+			retry = c.retryPolicy(result.StatusCode, result.Err)
+		}
+
+		if retry && attempt < c.Retries {
 			// Log retryable error with rate limit info if applicable
 			if suggestedWait > 0 {
 				c.logger.Warn(c.I18n(i18n.LogRateLimitedRetry),
@@ -396,7 +434,16 @@ func (c *Client) waitBackoff(ctx context.Context, attempt int, suggestedWait tim
 }
 
 // DoRequest performs an HTTP request with retry logic.
-func (c *Client) DoRequest(ctx context.Context, method, endpoint string, body any) (*Response, error) {
+//
+// Call options override the client settings for this request only.
+func (c *Client) DoRequest(ctx context.Context, method, endpoint string, body any, opts ...CallOption) (*Response, error) {
+	c = c.WithCallOptions(opts...)
+	if c.callTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, c.callTimeout)
+		defer cancel()
+	}
+
 	fullURL := c.BaseURL + endpoint
 	hasBody := body != nil
 
@@ -419,12 +466,12 @@ func (c *Client) DoRequest(ctx context.Context, method, endpoint string, body an
 }
 
 // Post performs a POST request.
-func (c *Client) Post(ctx context.Context, endpoint string, body any) (*Response, error) {
-	return c.DoRequest(ctx, http.MethodPost, endpoint, body)
+func (c *Client) Post(ctx context.Context, endpoint string, body any, opts ...CallOption) (*Response, error) {
+	return c.DoRequest(ctx, http.MethodPost, endpoint, body, opts...)
 }
 
 // Get performs a GET request with query parameters.
-func (c *Client) Get(ctx context.Context, endpoint string, params map[string]string) (*Response, error) {
+func (c *Client) Get(ctx context.Context, endpoint string, params map[string]string, opts ...CallOption) (*Response, error) {
 	if len(params) > 0 {
 		values := url.Values{}
 		for k, v := range params {
@@ -432,44 +479,79 @@ func (c *Client) Get(ctx context.Context, endpoint string, params map[string]str
 		}
 		endpoint = endpoint + "?" + values.Encode()
 	}
-	return c.DoRequest(ctx, http.MethodGet, endpoint, nil)
+	return c.DoRequest(ctx, http.MethodGet, endpoint, nil, opts...)
 }
 
 // ParseData parses the data field from an API response.
-// GSPAY2 API returns data as a JSON string that needs to be decoded.
+//
+// GSPAY2 returns data in several shapes: an object, an array of objects, a
+// JSON-encoded string, or an array of JSON-encoded strings. ParseData looks at
+// the first non-space byte to pick the shape instead of trying each shape in
+// turn. For arrays, the first element is used. data is the raw field of an
+// already buffered [Response], so the body is still decoded in two steps.
+//
+// It returns nil, nil only if the data field is absent, so callers must check
+// for a nil result. A data field without a value (null, "", or []) is an
+// error wrapping [errors.ErrInvalidJSON].
 func ParseData[T any](data json.RawMessage, lang i18n.Language) (*T, error) {
+	data = bytes.TrimSpace(data)
 	if len(data) == 0 {
 		return nil, nil
 	}
 
-	// First, try to unmarshal as a string (JSON encoded string)
-	var jsonStr string
-	if err := json.Unmarshal(data, &jsonStr); err == nil {
-		// It was a JSON string, now unmarshal the string content
-		data = json.RawMessage(jsonStr)
+	// Unwrap a JSON-encoded string once.
+	if data[0] == '"' {
+		var jsonStr string
+		if err := json.Unmarshal(data, &jsonStr); err != nil {
+			return nil, errors.New(lang, errors.ErrInvalidJSON, err)
+		}
+		data = bytes.TrimSpace([]byte(jsonStr))
+		if len(data) == 0 {
+			return nil, errors.New(lang, errors.ErrInvalidJSON, "empty data")
+		}
 	}
 
-	// Try to unmarshal as array first
-	var arr []T
-	if err := json.Unmarshal(data, &arr); err == nil && len(arr) > 0 {
-		return &arr[0], nil
+	var (
+		result T
+		err    error
+	)
+	switch data[0] {
+	case '[':
+		var found bool
+		found, err = decodeFirst(data, &result)
+		if err == nil && !found {
+			return nil, errors.New(lang, errors.ErrInvalidJSON, "empty data")
+		}
+	case 'n':
+		if string(data) == "null" {
+			return nil, errors.New(lang, errors.ErrInvalidJSON, "empty data")
+		}
+		err = json.Unmarshal(data, &result)
+	default:
+		err = json.Unmarshal(data, &result)
 	}
+	if err != nil {
+		return nil, errors.New(lang, errors.ErrInvalidJSON, err)
+	}
+	return &result, nil
+}
 
-	// Try to unmarshal as array of strings
-	var strArr []string
-	if err := json.Unmarshal(data, &strArr); err == nil && len(strArr) > 0 {
-		// Try to unmarshal the first string as T if it's JSON
-		var result T
-		if err := json.Unmarshal([]byte(strArr[0]), &result); err == nil {
-			return &result, nil
+// decodeFirst decodes the first element of a JSON array into v. Arrays of
+// JSON-encoded strings are decoded from the first string's content. It
+// reports false if the array is empty.
+func decodeFirst[T any](data []byte, v *T) (bool, error) {
+	if inner := bytes.TrimSpace(data[1:]); len(inner) > 0 && inner[0] == '"' {
+		var strs []string
+		if err := json.Unmarshal(data, &strs); err != nil || len(strs) == 0 {
+			return false, err
 		}
+		return true, json.Unmarshal([]byte(strs[0]), v)
 	}
 
-	// Try to unmarshal as single object
-	var result T
-	if err := json.Unmarshal(data, &result); err != nil {
-		return nil, errors.New(lang, errors.ErrInvalidJSON, err)
+	var arr []T
+	if err := json.Unmarshal(data, &arr); err != nil || len(arr) == 0 {
+		return false, err
 	}
-
-	return &result, nil
+	*v = arr[0]
+	return true, nil
 }
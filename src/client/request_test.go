@@ -19,6 +19,7 @@ import (
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 	"time"
 
@@ -243,6 +244,123 @@ func TestDoRequest(t *testing.T) {
 	})
 }
 
+func TestDoRequest_Classification(t *testing.T) {
+	serve := func(t *testing.T, status int, body string) *Client {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(status)
+			w.Write([]byte(body))
+		}))
+		t.Cleanup(server.Close)
+		return New("auth", "secret", WithBaseURL(server.URL), WithRetries(0))
+	}
+
+	tests := []struct {
+		name   string
+		status int
+		body   string
+		want   errors.Classification
+	}{
+		{"server error", 502, "bad gateway",
+			errors.Classification{Category: errors.CategoryServer, Retryable: true, MayHaveReachedServer: true}},
+		{"unauthorized", 401, "",
+			errors.Classification{Category: errors.CategoryAuth, MayHaveReachedServer: true}},
+		{"rate limited", 429, "",
+			errors.Classification{Category: errors.CategoryRateLimited, Retryable: true, MayHaveReachedServer: true}},
+		{"business rejection", 200, `{"code":400,"message":"invalid parameter"}`,
+			errors.Classification{Category: errors.CategoryRejected, MayHaveReachedServer: true}},
+		{"duplicate transaction", 200, `{"code":400,"message":"Duplicate transaction id"}`,
+			errors.Classification{Category: errors.CategoryRejected, MayHaveReachedServer: true}},
+		{"bank maintenance", 200, `{"code":500,"message":"Bank is under maintenance"}`,
+			errors.Classification{Category: errors.CategoryRejected, Retryable: true, MayHaveReachedServer: true}},
+		{"business not found", 200, `{"code":404,"message":"transaction not found"}`,
+			errors.Classification{Category: errors.CategoryRejected, MayHaveReachedServer: true}},
+		{"malformed response", 200, `{"code":`,
+			errors.Classification{Category: errors.CategoryServer, MayHaveReachedServer: true}},
+		{"empty response", 200, "",
+			errors.Classification{Category: errors.CategoryServer, Retryable: true, MayHaveReachedServer: true}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := serve(t, tt.status, tt.body).Get(t.Context(), "/test", nil)
+			require.Error(t, err)
+			assert.Equal(t, tt.want, errors.Classify(err))
+		})
+	}
+
+	t.Run("business error matches catalog sentinel", func(t *testing.T) {
+		c := serve(t, 200, `{"code":400,"message":"Saldo tidak cukup"}`)
+		c.Language = i18n.Indonesian
+		_, err := c.Get(t.Context(), "/test", nil)
+		require.Error(t, err)
+		assert.True(t, errors.Is(err, errors.ErrInsufficientBalance))
+		assert.Equal(t, i18n.Get(i18n.Indonesian, i18n.MsgExplainInsufficientBalance), errors.GetAPIError(err).Explanation())
+	})
+
+	t.Run("client business catalog", func(t *testing.T) {
+		catalog := errors.NewBusinessCatalog()
+		catalog.Register(errors.BusinessError{Code: 409, Err: errors.ErrDuplicateTransaction})
+		c := serve(t, 200, `{"code":409,"message":"conflict"}`)
+		WithBusinessCatalog(catalog)(c)
+		_, err := c.Get(t.Context(), "/test", nil)
+		assert.ErrorIs(t, err, errors.ErrDuplicateTransaction)
+
+		_, err = serve(t, 200, `{"code":409,"message":"conflict"}`).Get(t.Context(), "/test", nil)
+		assert.NotErrorIs(t, err, errors.ErrDuplicateTransaction)
+	})
+
+	t.Run("connection refused never reached the server", func(t *testing.T) {
+		server := httptest.NewServer(http.NotFoundHandler())
+		url := server.URL
+		server.Close()
+
+		c := New("auth", "secret", WithBaseURL(url), WithRetries(0))
+		_, err := c.Get(t.Context(), "/test", nil)
+		assert.Equal(t, errors.Classification{Category: errors.CategoryNetwork, Retryable: true}, errors.Classify(err))
+	})
+
+	t.Run("unencodable body never reached the server", func(t *testing.T) {
+		c := New("auth", "secret", WithRetries(0))
+		_, err := c.Post(t.Context(), "/test", map[string]any{"bad": make(chan int)})
+		assert.Equal(t, errors.CategoryValidation, errors.Classify(err).Category)
+		assert.False(t, errors.Classify(err).MayHaveReachedServer)
+	})
+
+	t.Run("canceled context", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(t.Context())
+		cancel()
+		_, err := serve(t, 200, `{"code":200}`).Get(ctx, "/test", nil)
+		assert.Equal(t, errors.CategoryCanceled, errors.Classify(err).Category)
+	})
+}
+
+func TestMaxResponseSize(t *testing.T) {
+	var calls int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.Write([]byte(`{"code":200,"message":"success","data":"` + strings.Repeat("x", 100) + `"}`))
+	}))
+	defer server.Close()
+
+	t.Run("rejects oversized bodies without retrying", func(t *testing.T) {
+		calls = 0
+		c := New("auth", "secret", WithBaseURL(server.URL), WithMaxResponseSize(64))
+		_, err := c.Get(t.Context(), "/test", nil)
+		assert.ErrorIs(t, err, errors.ErrResponseTooLarge)
+		assert.Equal(t, 1, calls)
+	})
+
+	t.Run("accepts bodies within the limit", func(t *testing.T) {
+		c := New("auth", "secret", WithBaseURL(server.URL), WithMaxResponseSize(1024))
+		_, err := c.Get(t.Context(), "/test", nil)
+		assert.NoError(t, err)
+	})
+
+	t.Run("NewE rejects non-positive limits", func(t *testing.T) {
+		_, err := NewE("auth", "secret", WithMaxResponseSize(0))
+		assert.ErrorIs(t, err, errors.ErrInvalidConfig)
+	})
+}
+
 func TestParseData(t *testing.T) {
 	t.Run("parses JSON string data", func(t *testing.T) {
 		data := json.RawMessage(`"{\"payment_url\":\"https://pay.example.com\"}"`)
@@ -296,12 +414,121 @@ func TestParseData(t *testing.T) {
 		assert.Equal(t, "https://pay.example.com", result.PaymentURL)
 	})
 
-	t.Run("handles empty data", func(t *testing.T) {
+	t.Run("rejects data without a value", func(t *testing.T) {
+		type testStruct struct{}
+		for _, data := range []string{`null`, `""`, `[]`, ` [ ] `, `"  "`, `"null"`} {
+			result, err := ParseData[testStruct](json.RawMessage(data), i18n.English)
+			assert.ErrorIs(t, err, errors.ErrInvalidJSON, data)
+			assert.Nil(t, result, data)
+		}
+	})
+
+	t.Run("returns nil for absent data", func(t *testing.T) {
 		type testStruct struct{}
 		result, err := ParseData[testStruct](nil, i18n.English)
 		require.NoError(t, err)
 		assert.Nil(t, result)
 	})
+
+	t.Run("decodes the first array element", func(t *testing.T) {
+		type testStruct struct {
+			ID int `json:"id"`
+		}
+		for _, data := range []string{
+			`[{"id":1},{"id":2}]`,
+			` ["{\"id\":1}", "{\"id\":2}"]`,
+			`"[{\"id\":1}]"`,
+			`"[\"{\\\"id\\\":1}\"]"`,
+		} {
+			result, err := ParseData[testStruct](json.RawMessage(data), i18n.English)
+			require.NoError(t, err, data)
+			require.NotNil(t, result, data)
+			assert.Equal(t, 1, result.ID, data)
+		}
+	})
+
+	t.Run("rejects malformed data", func(t *testing.T) {
+		type testStruct struct {
+			ID int `json:"id"`
+		}
+		for _, data := range []string{`{"id":`, `"{\"id\":"`, `["not json"]`, `[{"id":"x"}]`, `nul`, `"unterminated`} {
+			_, err := ParseData[testStruct](json.RawMessage(data), i18n.English)
+			assert.ErrorIs(t, err, errors.ErrInvalidJSON, data)
+		}
+	})
+}
+
+// legacyParseData is the previous ParseData implementation, kept to
+// benchmark the shape-detecting decoder against it.
+func legacyParseData[T any](data json.RawMessage, lang i18n.Language) (*T, error) {
+	if len(data) == 0 {
+		return nil, nil
+	}
+	var jsonStr string
+	if err := json.Unmarshal(data, &jsonStr); err == nil {
+		data = json.RawMessage(jsonStr)
+	}
+	var arr []T
+	if err := json.Unmarshal(data, &arr); err == nil && len(arr) > 0 {
+		return &arr[0], nil
+	}
+	var strArr []string
+	if err := json.Unmarshal(data, &strArr); err == nil && len(strArr) > 0 {
+		var result T
+		if err := json.Unmarshal([]byte(strArr[0]), &result); err == nil {
+			return &result, nil
+		}
+	}
+	var result T
+	if err := json.Unmarshal(data, &result); err != nil {
+		return nil, errors.New(lang, errors.ErrInvalidJSON, err)
+	}
+	return &result, nil
+}
+
+// benchStatus mirrors a typical status response payload.
+type benchStatus struct {
+	IDRPaymentID   string `json:"idrpayment_id"`
+	TransactionID  string `json:"transaction_id"`
+	PlayerUsername string `json:"player_username"`
+	Status         int    `json:"status"`
+	Amount         string `json:"amount"`
+	Completed      bool   `json:"completed"`
+	Signature      string `json:"signature"`
+}
+
+func BenchmarkParseData(b *testing.B) {
+	object := `{"idrpayment_id":"123","transaction_id":"TXN123456789","player_username":"demo_user",` +
+		`"status":1,"amount":"50000.00","completed":true,"signature":"0123456789abcdef0123456789abcdef"}`
+	quoted, _ := json.Marshal(object)
+	shapes := []struct {
+		name string
+		data json.RawMessage
+	}{
+		{"object", json.RawMessage(object)},
+		{"array", json.RawMessage("[" + object + "]")},
+		{"string", json.RawMessage(quoted)},
+		{"array_of_strings", json.RawMessage("[" + string(quoted) + "]")},
+	}
+
+	for _, shape := range shapes {
+		b.Run(shape.name+"/single_pass", func(b *testing.B) {
+			b.ReportAllocs()
+			for b.Loop() {
+				if _, err := ParseData[benchStatus](shape.data, i18n.English); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+		b.Run(shape.name+"/legacy", func(b *testing.B) {
+			b.ReportAllocs()
+			for b.Loop() {
+				if _, err := legacyParseData[benchStatus](shape.data, i18n.English); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
 }
 
 func TestResponse_IsSuccess(t *testing.T) {
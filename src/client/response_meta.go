@@ -0,0 +1,112 @@
+// Copyright 2026 H0llyW00dzZ
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"bytes"
+	"net/http"
+	"time"
+)
+
+// requestIDHeaders are response headers checked, in order, for a server
+// request ID.
+var requestIDHeaders = []string{"X-Request-Id", "X-Correlation-Id", "X-Trace-Id", "X-Amzn-Trace-Id"}
+
+// ResponseMeta describes the HTTP exchange behind a call, for debugging and
+// support tickets. Request it with [WithResponseMeta].
+//
+// StatusCode, Header, Body, and RequestID describe the last response
+// received; they are empty if no response arrived. The metadata is filled in
+// for failed calls too.
+type ResponseMeta struct {
+	// Method is the HTTP method.
+	Method string
+	// Endpoint is the request path, sanitized unless debug mode is enabled.
+	Endpoint string
+	// StatusCode is the HTTP status code.
+	StatusCode int
+	// Header holds the response headers.
+	Header http.Header
+	// Body is the raw response body.
+	Body []byte
+	// RequestID is the request ID returned by the server, if any.
+	RequestID string
+	// Attempts is the number of attempts made, including the first.
+	Attempts int
+	// Duration is the total time spent, including waits between retries.
+	Duration time.Duration
+	// Errors holds the error of each failed attempt, in order.
+	Errors []error
+}
+
+// WithResponseMeta fills meta with the HTTP details of the call.
+//
+// The meta is overwritten by each request of the call; do not share one
+// meta between concurrent calls.
+//
+// Example:
+//
+//	var meta client.ResponseMeta
+//	status, err := paymentSvc.GetStatus(ctx, txID, client.WithResponseMeta(&meta))
+//	if err != nil {
+//	    log.Printf("request %s failed after %d attempts (HTTP %d)", meta.RequestID, meta.Attempts, meta.StatusCode)
+//	}
+func WithResponseMeta(meta *ResponseMeta) CallOption {
+	return func(c *Client) {
+		c.meta = meta
+	}
+}
+
+// startMeta resets the response metadata for a new request.
+func (c *Client) startMeta(method, endpoint string) {
+	if c.meta != nil {
+		*c.meta = ResponseMeta{Method: method, Endpoint: c.LogEndpoint(endpoint)}
+	}
+}
+
+// recordResponse stores the details of a received response.
+func (c *Client) recordResponse(resp *http.Response, body []byte) {
+	if c.meta == nil {
+		return
+	}
+	c.meta.StatusCode = resp.StatusCode
+	c.meta.Header = resp.Header.Clone()
+	c.meta.Body = bytes.Clone(body)
+	c.meta.RequestID = ""
+	for _, key := range requestIDHeaders {
+		if id := resp.Header.Get(key); id != "" {
+			c.meta.RequestID = id
+			break
+		}
+	}
+}
+
+// recordAttempt counts an attempt and its error, if any.
+func (c *Client) recordAttempt(err error) {
+	if c.meta == nil {
+		return
+	}
+	c.meta.Attempts++
+	if err != nil {
+		c.meta.Errors = append(c.meta.Errors, err)
+	}
+}
+
+// finishMeta records the total duration of the request.
+func (c *Client) finishMeta(start time.Time) {
+	if c.meta != nil {
+		c.meta.Duration = time.Since(start)
+	}
+}
@@ -0,0 +1,110 @@
+// Copyright 2026 H0llyW00dzZ
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/H0llyW00dzZ/gspay-go-sdk/src/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithResponseMeta(t *testing.T) {
+	t.Run("records a successful exchange", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("X-Request-Id", "req-123")
+			w.Write([]byte(`{"code":200,"message":"success"}`))
+		}))
+		defer server.Close()
+
+		c := New("secret-auth", "secret", WithBaseURL(server.URL))
+		var meta ResponseMeta
+		_, err := c.Get(t.Context(), "/v2/integrations/operators/secret-auth/idr/payment/status", nil, WithResponseMeta(&meta))
+		require.NoError(t, err)
+
+		assert.Equal(t, http.MethodGet, meta.Method)
+		assert.NotContains(t, meta.Endpoint, "secret-auth", "endpoint is sanitized")
+		assert.Equal(t, http.StatusOK, meta.StatusCode)
+		assert.Equal(t, "req-123", meta.RequestID)
+		assert.Equal(t, "req-123", meta.Header.Get("X-Request-Id"))
+		assert.JSONEq(t, `{"code":200,"message":"success"}`, string(meta.Body))
+		assert.Equal(t, 1, meta.Attempts)
+		assert.Empty(t, meta.Errors)
+		assert.Positive(t, meta.Duration)
+	})
+
+	t.Run("records retries and attempt errors", func(t *testing.T) {
+		var calls int
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			calls++
+			w.Header().Set("X-Trace-Id", "trace-1")
+			if calls < 3 {
+				w.WriteHeader(http.StatusBadGateway)
+				w.Write([]byte("bad gateway"))
+				return
+			}
+			w.Write([]byte(`{"code":200,"message":"success"}`))
+		}))
+		defer server.Close()
+
+		c := New("auth", "secret", WithBaseURL(server.URL), WithRetryWait(time.Millisecond, time.Millisecond))
+		var meta ResponseMeta
+		_, err := c.Post(t.Context(), "/test", map[string]string{"a": "b"}, WithResponseMeta(&meta))
+		require.NoError(t, err)
+
+		assert.Equal(t, 3, meta.Attempts)
+		require.Len(t, meta.Errors, 2)
+		assert.True(t, errors.IsAPIError(meta.Errors[0]))
+		assert.Equal(t, http.StatusOK, meta.StatusCode)
+		assert.Equal(t, "trace-1", meta.RequestID)
+	})
+
+	t.Run("records failed calls", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Write([]byte(`{"code":400,"message":"bad request"}`))
+		}))
+		defer server.Close()
+
+		c := New("auth", "secret", WithBaseURL(server.URL))
+		var meta ResponseMeta
+		_, err := c.Get(t.Context(), "/test", nil, WithResponseMeta(&meta))
+		require.Error(t, err)
+
+		assert.Equal(t, 1, meta.Attempts)
+		assert.Equal(t, http.StatusOK, meta.StatusCode)
+		assert.Empty(t, meta.RequestID)
+		assert.Contains(t, string(meta.Body), "bad request")
+		require.Len(t, meta.Errors, 1)
+		assert.ErrorIs(t, err, meta.Errors[0])
+	})
+
+	t.Run("resets between requests", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Write([]byte(`{"code":200,"message":"success"}`))
+		}))
+		defer server.Close()
+
+		c := New("auth", "secret", WithBaseURL(server.URL))
+		meta := ResponseMeta{Attempts: 7, Errors: []error{errors.ErrRequestFailed}}
+		_, err := c.Get(t.Context(), "/test", nil, WithResponseMeta(&meta))
+		require.NoError(t, err)
+		assert.Equal(t, 1, meta.Attempts)
+		assert.Empty(t, meta.Errors)
+	})
+}
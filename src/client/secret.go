@@ -0,0 +1,212 @@
+// Copyright 2026 H0llyW00dzZ
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"context"
+	"slices"
+	"sync/atomic"
+	"time"
+
+	"github.com/H0llyW00dzZ/gspay-go-sdk/src/i18n"
+)
+
+// DefaultSecretID identifies the secret key passed to [New] when no
+// [SecretProvider] is configured.
+const DefaultSecretID = "default"
+
+// Secret is an operator secret key with an identifier for logging.
+type Secret struct {
+	// ID identifies the key in logs (e.g., "2026-10"). It must not be secret.
+	ID string
+	// Value is the secret key itself.
+	Value string
+	// ExpiresAt is when the key stops being accepted for verification.
+	// The zero value means the key never expires.
+	ExpiresAt time.Time
+}
+
+// String returns the key ID so a [Secret] never leaks its value into logs.
+func (s Secret) String() string { return s.ID }
+
+// expired reports whether the key is no longer accepted at time now.
+func (s Secret) expired(now time.Time) bool {
+	return !s.ExpiresAt.IsZero() && !now.Before(s.ExpiresAt)
+}
+
+// SecretProvider supplies the secret keys used for signatures.
+//
+// Outgoing requests are signed with [SecretProvider.SigningSecret]. Callbacks
+// and status responses are accepted if they match any key returned by
+// [SecretProvider.VerificationSecrets]. Implementations must be safe for
+// concurrent use.
+type SecretProvider interface {
+	// SigningSecret returns the active key.
+	SigningSecret() Secret
+	// VerificationSecrets returns every key currently accepted, active key first.
+	VerificationSecrets() []Secret
+}
+
+// secretSet is an immutable snapshot held by [RotatingSecrets].
+type secretSet struct {
+	active   Secret
+	accepted []Secret
+}
+
+// RotatingSecrets is a [SecretProvider] whose keys can be swapped at runtime.
+//
+// During a rotation the previous key stays accepted for verification until
+// its expiry, so callbacks signed before the switch still verify:
+//
+//	secrets := client.NewRotatingSecrets(client.Secret{ID: "2026-09", Value: oldKey})
+//	c := client.New(authKey, oldKey, client.WithSecretProvider(secrets))
+//
+//	// Later, after the new key is enabled in the GSPAY2 dashboard:
+//	secrets.Rotate(client.Secret{ID: "2026-10", Value: newKey}, 24*time.Hour)
+type RotatingSecrets struct {
+	set atomic.Pointer[secretSet]
+	now func() time.Time
+}
+
+// NewRotatingSecrets creates a provider that signs with active and also
+// accepts the previous keys for verification.
+func NewRotatingSecrets(active Secret, previous ...Secret) *RotatingSecrets {
+	r := &RotatingSecrets{now: time.Now}
+	r.Set(active, previous...)
+	return r
+}
+
+// Set atomically replaces the active key and the previous keys.
+func (r *RotatingSecrets) Set(active Secret, previous ...Secret) {
+	r.set.Store(&secretSet{
+		active:   active,
+		accepted: append([]Secret{active}, previous...),
+	})
+}
+
+// Rotate atomically makes next the active key. The current active key stays
+// accepted for verification for the grace period; keys that have already
+// expired are dropped.
+func (r *RotatingSecrets) Rotate(next Secret, grace time.Duration) {
+	now := r.now()
+	for {
+		old := r.set.Load()
+		prev := old.active
+		prev.ExpiresAt = now.Add(grace)
+
+		accepted := []Secret{next, prev}
+		for _, s := range old.accepted[1:] {
+			if !s.expired(now) && s.ID != next.ID && s.ID != prev.ID {
+				accepted = append(accepted, s)
+			}
+		}
+		if r.set.CompareAndSwap(old, &secretSet{active: next, accepted: accepted}) {
+			return
+		}
+	}
+}
+
+// SigningSecret returns the active key.
+func (r *RotatingSecrets) SigningSecret() Secret {
+	return r.set.Load().active
+}
+
+// VerificationSecrets returns the active key followed by every previous key
+// that has not expired.
+func (r *RotatingSecrets) VerificationSecrets() []Secret {
+	set := r.set.Load()
+	now := r.now()
+	return slices.DeleteFunc(slices.Clone(set.accepted), func(s Secret) bool {
+		return s != set.active && s.expired(now)
+	})
+}
+
+// WithSecretProvider sets the source of secret keys for signing and
+// verification, replacing the secret key passed to [New].
+//
+// Use [RotatingSecrets] to rotate keys without rejecting callbacks signed
+// with the previous key.
+//
+// Example:
+//
+//	secrets := client.NewRotatingSecrets(
+//	    client.Secret{ID: "2026-10", Value: newKey},
+//	    client.Secret{ID: "2026-09", Value: oldKey, ExpiresAt: cutover.Add(24 * time.Hour)},
+//	)
+//	c := client.New("auth", newKey, client.WithSecretProvider(secrets))
+func WithSecretProvider(p SecretProvider) Option {
+	return func(c *Client) {
+		c.secrets = p
+	}
+}
+
+// SigningSecret returns the secret key used to sign outgoing requests.
+func (c *Client) SigningSecret() string {
+	if c.secrets == nil {
+		return c.SecretKey
+	}
+	return c.secrets.SigningSecret().Value
+}
+
+// verificationSecrets returns the keys accepted for incoming signatures.
+func (c *Client) verificationSecrets() []Secret {
+	if c.secrets == nil {
+		return []Secret{{ID: DefaultSecretID, Value: c.SecretKey}}
+	}
+	return c.secrets.VerificationSecrets()
+}
+
+// MatchSignature verifies a received signature against every accepted key.
+//
+// The signature is expected to be computed over data followed by the secret
+// key, as in every GSPAY2 signature formula. It returns the matching key and
+// true, or a zero [Secret] and false. Matches on a key other than the active
+// one are logged at warn level so lingering old keys are noticed.
+//
+// Without a [SecretProvider], the secret key comes from [Client.Resolve]. If
+// it cannot be resolved, the error wrapping [errors.ErrCredentialsUnavailable]
+// is returned, so a secret store outage is not mistaken for a forged
+// signature.
+func (c *Client) MatchSignature(data, received string) (Secret, bool, error) {
+	if c.secrets == nil {
+		resolved, err := c.Resolve(context.Background())
+		if err != nil {
+			c.Logger().Error(c.I18n(i18n.LogCredentialsUnavailable), "error", err.Error())
+			return Secret{}, false, err
+		}
+		c = resolved
+	}
+
+	var (
+		matched Secret
+		found   bool
+	)
+	// Every key is tried so the time taken does not reveal which one matched.
+	for _, s := range c.verificationSecrets() {
+		if c.VerifySignature(c.GenerateSignature(data+s.Value), received) && !found {
+			matched, found = s, true
+		}
+	}
+	if !found {
+		return Secret{}, false, nil
+	}
+
+	if c.secrets != nil && matched.Value != c.SigningSecret() {
+		c.Logger().Warn(c.I18n(i18n.LogPreviousSecretKeyMatched), "keyID", matched.ID)
+	} else {
+		c.Logger().Debug(c.I18n(i18n.LogSecretKeyMatched), "keyID", matched.ID)
+	}
+	return matched, true, nil
+}
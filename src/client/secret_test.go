@@ -0,0 +1,147 @@
+// Copyright 2026 H0llyW00dzZ
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/H0llyW00dzZ/gspay-go-sdk/src/i18n"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRotatingSecrets(t *testing.T) {
+	now := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+	newSecrets := func(active Secret, previous ...Secret) *RotatingSecrets {
+		r := NewRotatingSecrets(active, previous...)
+		r.now = func() time.Time { return now }
+		return r
+	}
+
+	t.Run("signs with the active key and accepts previous keys", func(t *testing.T) {
+		r := newSecrets(Secret{ID: "new", Value: "k2"}, Secret{ID: "old", Value: "k1"})
+		assert.Equal(t, "new", r.SigningSecret().ID)
+		assert.Equal(t, []Secret{{ID: "new", Value: "k2"}, {ID: "old", Value: "k1"}}, r.VerificationSecrets())
+	})
+
+	t.Run("drops expired previous keys", func(t *testing.T) {
+		r := newSecrets(
+			Secret{ID: "new", Value: "k3"},
+			Secret{ID: "old", Value: "k2", ExpiresAt: now.Add(time.Hour)},
+			Secret{ID: "older", Value: "k1", ExpiresAt: now},
+		)
+		var ids []string
+		for _, s := range r.VerificationSecrets() {
+			ids = append(ids, s.ID)
+		}
+		assert.Equal(t, []string{"new", "old"}, ids)
+	})
+
+	t.Run("never drops the active key", func(t *testing.T) {
+		r := newSecrets(Secret{ID: "new", Value: "k1", ExpiresAt: now.Add(-time.Hour)})
+		assert.Len(t, r.VerificationSecrets(), 1)
+	})
+
+	t.Run("rotate keeps the previous key for the grace period", func(t *testing.T) {
+		r := newSecrets(Secret{ID: "v1", Value: "k1"})
+		r.Rotate(Secret{ID: "v2", Value: "k2"}, time.Hour)
+
+		assert.Equal(t, "v2", r.SigningSecret().ID)
+		secrets := r.VerificationSecrets()
+		require.Len(t, secrets, 2)
+		assert.Equal(t, "v1", secrets[1].ID)
+		assert.Equal(t, now.Add(time.Hour), secrets[1].ExpiresAt)
+
+		now = now.Add(time.Hour)
+		r.Rotate(Secret{ID: "v3", Value: "k3"}, time.Hour)
+		var ids []string
+		for _, s := range r.VerificationSecrets() {
+			ids = append(ids, s.ID)
+		}
+		assert.Equal(t, []string{"v3", "v2"}, ids, "v1 expired during the second rotation")
+	})
+
+	t.Run("string hides the value", func(t *testing.T) {
+		s := Secret{ID: "v1", Value: "super-secret"}
+		assert.Equal(t, "v1", s.String())
+	})
+
+	t.Run("concurrent rotation and reads", func(t *testing.T) {
+		r := NewRotatingSecrets(Secret{ID: "v0", Value: "k0"})
+		var wg sync.WaitGroup
+		for i := range 20 {
+			wg.Go(func() {
+				if i%2 == 0 {
+					r.Rotate(Secret{ID: "v", Value: "k"}, time.Minute)
+					return
+				}
+				assert.NotEmpty(t, r.VerificationSecrets())
+				assert.NotEmpty(t, r.SigningSecret().Value)
+			})
+		}
+		wg.Wait()
+	})
+}
+
+func TestClient_MatchSignature(t *testing.T) {
+	data := "PAY12350000.00TXN1234567891"
+
+	t.Run("without provider uses the secret key", func(t *testing.T) {
+		c := New("auth", "secret")
+		assert.Equal(t, "secret", c.SigningSecret())
+
+		s, ok, _ := c.MatchSignature(data, c.GenerateSignature(data+"secret"))
+		assert.True(t, ok)
+		assert.Equal(t, DefaultSecretID, s.ID)
+
+		_, ok, _ = c.MatchSignature(data, c.GenerateSignature(data+"other"))
+		assert.False(t, ok)
+	})
+
+	t.Run("provider replaces the secret key", func(t *testing.T) {
+		mock := &MockLogger{}
+		secrets := NewRotatingSecrets(Secret{ID: "v1", Value: "k1"})
+		c := New("auth", "ignored", WithSecretProvider(secrets), WithLogger(mock))
+		assert.Equal(t, "k1", c.SigningSecret())
+
+		oldSig := c.GenerateSignature(data + "k1")
+		secrets.Rotate(Secret{ID: "v2", Value: "k2"}, time.Hour)
+		assert.Equal(t, "k2", c.SigningSecret(), "rotation is visible without rebuilding the client")
+
+		s, ok, _ := c.MatchSignature(data, c.GenerateSignature(data+"k2"))
+		assert.True(t, ok)
+		assert.Equal(t, "v2", s.ID)
+		require.Len(t, mock.DebugCalls, 1)
+		assert.Equal(t, i18n.Get(i18n.English, i18n.LogSecretKeyMatched), mock.DebugCalls[0].Msg)
+		assert.Equal(t, []any{"keyID", "v2"}, mock.DebugCalls[0].KeysAndValues)
+
+		s, ok, _ = c.MatchSignature(data, oldSig)
+		assert.True(t, ok)
+		assert.Equal(t, "v1", s.ID)
+		require.Len(t, mock.WarnCalls, 1)
+		assert.Equal(t, i18n.Get(i18n.English, i18n.LogPreviousSecretKeyMatched), mock.WarnCalls[0].Msg)
+		assert.Equal(t, []any{"keyID", "v1"}, mock.WarnCalls[0].KeysAndValues)
+	})
+
+	t.Run("call options share the provider", func(t *testing.T) {
+		secrets := NewRotatingSecrets(Secret{ID: "v1", Value: "k1"})
+		c := New("auth", "ignored", WithSecretProvider(secrets))
+		scoped := c.WithCallOptions(WithCallLanguage(i18n.Indonesian))
+		secrets.Rotate(Secret{ID: "v2", Value: "k2"}, time.Hour)
+		assert.Equal(t, "k2", scoped.SigningSecret())
+	})
+}
@@ -0,0 +1,188 @@
+// Copyright 2026 H0llyW00dzZ
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package constants
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/H0llyW00dzZ/gspay-go-sdk/src/i18n"
+)
+
+// AccountRule describes the account number format a bank or e-wallet accepts.
+//
+// Account numbers are always digit-only. The rules are deliberately loose
+// where banks issue several formats, so they catch typos without rejecting
+// real accounts.
+type AccountRule struct {
+	// MinLength and MaxLength bound the number of digits.
+	MinLength int
+	MaxLength int
+	// PhonePrefixes marks an e-wallet whose account number is a mobile phone
+	// number; it must start with one of the prefixes.
+	PhonePrefixes []string
+}
+
+// DefaultAccountRule applies to banks without a specific rule.
+var DefaultAccountRule = AccountRule{MinLength: 5, MaxLength: 20}
+
+// AccountRulesIDR contains account number rules for [BanksIDR].
+var AccountRulesIDR = map[string]AccountRule{
+	"BCA":     {MinLength: 10, MaxLength: 10},
+	"BRI":     {MinLength: 15, MaxLength: 15},
+	"MANDIRI": {MinLength: 13, MaxLength: 13},
+	"BNI":     {MinLength: 10, MaxLength: 10},
+	"CIMB":    {MinLength: 13, MaxLength: 14},
+	"PERMATA": {MinLength: 10, MaxLength: 16},
+	"DANAMON": {MinLength: 9, MaxLength: 13},
+	"DANA":    {MinLength: 10, MaxLength: 14, PhonePrefixes: []string{"08", "628"}},
+	"OVO":     {MinLength: 10, MaxLength: 14, PhonePrefixes: []string{"08", "628"}},
+}
+
+// AccountRulesMYR contains account number rules for [BanksMYR].
+var AccountRulesMYR = map[string]AccountRule{
+	"AFFB": {MinLength: 12, MaxLength: 12},
+	"AGRO": {MinLength: 16, MaxLength: 16},
+	"ALLB": {MinLength: 15, MaxLength: 15},
+	"AMB":  {MinLength: 13, MaxLength: 13},
+	"BIM":  {MinLength: 14, MaxLength: 14},
+	"BMML": {MinLength: 14, MaxLength: 14},
+	"BKR":  {MinLength: 12, MaxLength: 12},
+	"BSN":  {MinLength: 16, MaxLength: 16},
+	"CIMB": {MinLength: 10, MaxLength: 14},
+	"CITB": {MinLength: 10, MaxLength: 10},
+	"HLB":  {MinLength: 11, MaxLength: 11},
+	"HSBC": {MinLength: 12, MaxLength: 12},
+	"MBB":  {MinLength: 12, MaxLength: 12},
+	"OCBC": {MinLength: 10, MaxLength: 10},
+	"PBB":  {MinLength: 10, MaxLength: 10},
+	"RHB":  {MinLength: 14, MaxLength: 14},
+	"SCB":  {MinLength: 10, MaxLength: 10},
+	"UOB":  {MinLength: 10, MaxLength: 10},
+	"TNG":  {MinLength: 10, MaxLength: 12, PhonePrefixes: []string{"01", "601"}},
+}
+
+// AccountRulesTHB contains account number rules for [BanksTHB].
+var AccountRulesTHB = map[string]AccountRule{
+	"BBL":   {MinLength: 10, MaxLength: 10},
+	"KBANK": {MinLength: 10, MaxLength: 10},
+	"KTB":   {MinLength: 10, MaxLength: 10},
+	"TMB":   {MinLength: 10, MaxLength: 10},
+	"SCB":   {MinLength: 10, MaxLength: 10},
+	"CIMB":  {MinLength: 10, MaxLength: 10},
+	"UOB":   {MinLength: 10, MaxLength: 10},
+	"BAY":   {MinLength: 10, MaxLength: 10},
+	"GSB":   {MinLength: 12, MaxLength: 12},
+	"GHB":   {MinLength: 12, MaxLength: 12},
+	"BAAC":  {MinLength: 12, MaxLength: 12},
+	"TISCO": {MinLength: 10, MaxLength: 10},
+	"KKP":   {MinLength: 10, MaxLength: 10},
+	"LHB":   {MinLength: 10, MaxLength: 10},
+}
+
+// GetAccountRule returns the account number rule for a bank code and
+// currency, or [DefaultAccountRule] if the bank has no specific rule.
+func GetAccountRule(bankCode string, currency Currency) AccountRule {
+	if b, ok := getBank(strings.ToUpper(bankCode), currency); ok && b.AccountRule.MaxLength > 0 {
+		return b.AccountRule
+	}
+	return DefaultAccountRule
+}
+
+// AccountReason identifies why an account number was rejected.
+type AccountReason int
+
+// Account number rejection reasons.
+const (
+	// AccountReasonNotNumeric means the number is empty or has non-digits.
+	AccountReasonNotNumeric AccountReason = iota + 1
+	// AccountReasonLength means the number has the wrong number of digits.
+	AccountReasonLength
+	// AccountReasonPhone means an e-wallet number is not a mobile number.
+	AccountReasonPhone
+)
+
+// AccountNumberError reports an account number that does not match the rule
+// of its bank.
+type AccountNumberError struct {
+	BankCode string
+	Rule     AccountRule
+	Reason   AccountReason
+	// Lang is the language of the message. Default is [i18n.English].
+	Lang i18n.Language
+}
+
+// Error implements the error interface.
+func (e *AccountNumberError) Error() string {
+	key, args := e.Message()
+	return fmt.Sprintf(i18n.Get(e.Lang, key), args...)
+}
+
+// Message returns the message key and arguments of the error, so callers
+// can render it in another language.
+func (e *AccountNumberError) Message() (i18n.MessageKey, []any) {
+	switch e.Reason {
+	case AccountReasonLength:
+		if e.Rule.MinLength == e.Rule.MaxLength {
+			return i18n.MsgAccountNumberLength, []any{e.BankCode, e.Rule.MinLength}
+		}
+		return i18n.MsgAccountNumberRange, []any{e.BankCode, e.Rule.MinLength, e.Rule.MaxLength}
+	case AccountReasonPhone:
+		return i18n.MsgAccountNumberPhone, []any{e.BankCode, strings.Join(e.Rule.PhonePrefixes, "/")}
+	default:
+		return i18n.MsgAccountNumberDigits, []any{e.BankCode}
+	}
+}
+
+// Check validates number against the rule. It returns 0 if number is valid.
+func (r AccountRule) Check(number string) AccountReason {
+	if number == "" || strings.TrimLeft(number, "0123456789") != "" {
+		return AccountReasonNotNumeric
+	}
+	if len(number) < r.MinLength || len(number) > r.MaxLength {
+		return AccountReasonLength
+	}
+	if len(r.PhonePrefixes) > 0 && !hasAnyPrefix(number, r.PhonePrefixes) {
+		return AccountReasonPhone
+	}
+	return 0
+}
+
+// ValidateAccountNumber checks an account number against the rule of the
+// bank. It returns an [*AccountNumberError] with an English message if the
+// number is invalid; set its Lang field to localize the message.
+//
+// Example:
+//
+//	if err := constants.ValidateAccountNumber(constants.CurrencyIDR, "BCA", "123456789"); err != nil {
+//	    fmt.Println(err) // account number for BCA must be 10 digits
+//	}
+func ValidateAccountNumber(currency Currency, bankCode, number string) error {
+	rule := GetAccountRule(bankCode, currency)
+	if reason := rule.Check(number); reason != 0 {
+		return &AccountNumberError{BankCode: strings.ToUpper(bankCode), Rule: rule, Reason: reason}
+	}
+	return nil
+}
+
+// hasAnyPrefix reports whether s starts with any of prefixes.
+func hasAnyPrefix(s string, prefixes []string) bool {
+	for _, p := range prefixes {
+		if strings.HasPrefix(s, p) {
+			return true
+		}
+	}
+	return false
+}
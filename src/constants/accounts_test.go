@@ -0,0 +1,104 @@
+// Copyright 2026 H0llyW00dzZ
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package constants
+
+import (
+	"testing"
+
+	"github.com/H0llyW00dzZ/gspay-go-sdk/src/i18n"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAccountRules(t *testing.T) {
+	t.Run("every bank has a rule", func(t *testing.T) {
+		for code := range BanksIDR {
+			assert.Contains(t, AccountRulesIDR, code)
+		}
+		for code := range BanksTHB {
+			assert.Contains(t, AccountRulesTHB, code)
+		}
+		for code := range AccountRulesMYR {
+			assert.Contains(t, BanksMYR, code)
+		}
+	})
+
+	t.Run("rules are consistent", func(t *testing.T) {
+		for _, rules := range []map[string]AccountRule{AccountRulesIDR, AccountRulesMYR, AccountRulesTHB} {
+			for code, rule := range rules {
+				assert.Positive(t, rule.MinLength, code)
+				assert.LessOrEqual(t, rule.MinLength, rule.MaxLength, code)
+			}
+		}
+	})
+
+	t.Run("unknown bank uses default rule", func(t *testing.T) {
+		assert.Equal(t, DefaultAccountRule.MinLength, GetAccountRule("RYT", CurrencyMYR).MinLength)
+		assert.Equal(t, DefaultAccountRule.MaxLength, GetAccountRule("BCA", Currency("XXX")).MaxLength)
+	})
+}
+
+func TestValidateAccountNumber(t *testing.T) {
+	tests := []struct {
+		name     string
+		currency Currency
+		bank     string
+		number   string
+		reason   AccountReason
+	}{
+		{"BCA valid", CurrencyIDR, "BCA", "1234567890", 0},
+		{"lowercase bank code", CurrencyIDR, "mandiri", "1234567890123", 0},
+		{"BRI too short", CurrencyIDR, "BRI", "1234567890", AccountReasonLength},
+		{"letters", CurrencyIDR, "BCA", "12345abcde", AccountReasonNotNumeric},
+		{"empty", CurrencyIDR, "BCA", "", AccountReasonNotNumeric},
+		{"DANA local mobile", CurrencyIDR, "DANA", "081234567890", 0},
+		{"DANA international mobile", CurrencyIDR, "DANA", "6281234567890", 0},
+		{"DANA with plus sign", CurrencyIDR, "DANA", "+6281234567890", AccountReasonNotNumeric},
+		{"DANA landline", CurrencyIDR, "DANA", "0211234567", AccountReasonPhone},
+		{"TNG mobile", CurrencyMYR, "TNG", "60123456789", 0},
+		{"Maybank", CurrencyMYR, "MBB", "123456789012", 0},
+		{"GSB too short", CurrencyTHB, "GSB", "1234567890", AccountReasonLength},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateAccountNumber(tt.currency, tt.bank, tt.number)
+			if tt.reason == 0 {
+				assert.NoError(t, err)
+				return
+			}
+			var accErr *AccountNumberError
+			require.ErrorAs(t, err, &accErr)
+			assert.Equal(t, tt.reason, accErr.Reason)
+		})
+	}
+
+	t.Run("error messages", func(t *testing.T) {
+		assert.EqualError(t, ValidateAccountNumber(CurrencyIDR, "bca", "1"), "account number for BCA must be 10 digits")
+		assert.EqualError(t, ValidateAccountNumber(CurrencyIDR, "CIMB", "1"), "account number for CIMB must be 13 to 14 digits")
+		assert.EqualError(t, ValidateAccountNumber(CurrencyIDR, "OVO", "1234567890"),
+			"account number for OVO must be a mobile number starting with 08/628")
+		assert.EqualError(t, ValidateAccountNumber(CurrencyIDR, "BCA", "x"), "account number for BCA must contain only digits")
+	})
+
+	t.Run("localized messages", func(t *testing.T) {
+		var accErr *AccountNumberError
+		require.ErrorAs(t, ValidateAccountNumber(CurrencyIDR, "bca", "1"), &accErr)
+		accErr.Lang = i18n.Indonesian
+		assert.EqualError(t, accErr, "nomor rekening untuk BCA harus 10 digit")
+
+		accErr.Reason = AccountReasonNotNumeric
+		assert.EqualError(t, accErr, "nomor rekening untuk BCA hanya boleh berisi angka")
+	})
+}
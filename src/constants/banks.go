@@ -14,6 +14,12 @@
 
 package constants
 
+import (
+	"slices"
+	"strings"
+	"sync"
+)
+
 // Currency represents supported currencies for bank operations.
 type Currency string
 
@@ -25,6 +31,8 @@ const (
 	CurrencyMYR Currency = "MYR"
 	// CurrencyTHB represents Thai Baht.
 	CurrencyTHB Currency = "THB"
+	// CurrencyUSDT represents Tether (TRC20) balances.
+	CurrencyUSDT Currency = "USDT"
 )
 
 // BanksIDR contains Indonesian bank codes and names.
@@ -82,44 +90,346 @@ var BanksTHB = map[string]string{
 	"LHB":   "LAND AND HOUSES BANK PUBLIC COMPANY LIMITED",
 }
 
-// GetBankName returns the bank name for a given bank code and currency.
-// Returns an empty string if the bank code is not found.
-func GetBankName(bankCode string, currency Currency) string {
+// BankType distinguishes bank accounts from e-wallets.
+type BankType string
+
+// Bank types.
+const (
+	// BankTypeBank is a bank account.
+	BankTypeBank BankType = "bank"
+	// BankTypeEWallet is an e-wallet, usually addressed by mobile number.
+	BankTypeEWallet BankType = "ewallet"
+)
+
+// Bank describes a bank or e-wallet that GSPAY2 accepts as a target.
+type Bank struct {
+	// Code is the GSPAY2 bank code, e.g. "MANDIRI".
+	Code string
+	// Name is the full display name.
+	Name string
+	// ShortName is a compact display name, e.g. "Mandiri".
+	ShortName string
+	// Type is [BankTypeBank] or [BankTypeEWallet].
+	Type BankType
+	// Currency is the currency the bank code belongs to.
+	Currency Currency
+	// SWIFT is the SWIFT/BIC code, if known.
+	SWIFT string
+	// Payout reports whether the bank can be used as a payout target.
+	Payout bool
+	// Aliases are additional names accepted by [LookupBank].
+	Aliases []string
+	// AccountRule is the account number format. The zero value means
+	// [DefaultAccountRule].
+	AccountRule AccountRule
+}
+
+// bankMeta holds the metadata not present in the BanksIDR/MYR/THB maps.
+type bankMeta struct {
+	shortName string
+	swift     string
+	aliases   []string
+}
+
+// bankMetaIDR contains metadata for [BanksIDR].
+var bankMetaIDR = map[string]bankMeta{
+	"BCA":     {"BCA", "CENAIDJA", []string{"Bank Central Asia"}},
+	"BRI":     {"BRI", "BRINIDJA", []string{"Bank Rakyat Indonesia"}},
+	"MANDIRI": {"Mandiri", "BMRIIDJA", nil},
+	"BNI":     {"BNI", "BNINIDJA", []string{"Bank Negara Indonesia"}},
+	"CIMB":    {"CIMB Niaga", "BNIAIDJA", []string{"CIMB Niaga"}},
+	"PERMATA": {"Permata", "BBBAIDJA", nil},
+	"DANAMON": {"Danamon", "BDINIDJA", nil},
+	"DANA":    {"DANA", "", nil},
+	"OVO":     {"OVO", "", nil},
+}
+
+// bankMetaMYR contains metadata for [BanksMYR].
+var bankMetaMYR = map[string]bankMeta{
+	"AFFB": {"Affin", "PHBMMYKL", nil},
+	"AGRO": {"Agrobank", "AGOBMYKL", nil},
+	"ALLB": {"Alliance", "MFBBMYKL", nil},
+	"AMB":  {"AmBank", "ARBKMYKL", nil},
+	"BIM":  {"Bank Islam", "BIMBMYKL", nil},
+	"BMML": {"Muamalat", "BMMBMYKL", nil},
+	"BKR":  {"Bank Rakyat", "BKRMMYKL", nil},
+	"BSN":  {"BSN", "BSNAMYK1", nil},
+	"CIMB": {"CIMB", "CIBBMYKL", nil},
+	"CITB": {"Citibank", "CITIMYKL", nil},
+	"HLB":  {"Hong Leong", "HLBBMYKL", nil},
+	"HSBC": {"HSBC", "HBMBMYKL", nil},
+	"MBB":  {"Maybank", "MBBEMYKL", []string{"Malayan Banking"}},
+	"OCBC": {"OCBC", "OCBCMYKL", nil},
+	"PBB":  {"Public Bank", "PBBEMYKL", nil},
+	"RHB":  {"RHB", "RHBBMYKL", nil},
+	"SCB":  {"Standard Chartered", "SCBLMYKX", nil},
+	"UOB":  {"UOB", "UOVBMYKL", nil},
+	"TNG":  {"Touch 'n Go", "", []string{"Touch n Go", "TnG eWallet"}},
+	"RYT":  {"Ryt Bank", "", nil},
+}
+
+// bankMetaTHB contains metadata for [BanksTHB].
+var bankMetaTHB = map[string]bankMeta{
+	"BBL":   {"Bangkok Bank", "BKKBTHBK", nil},
+	"KBANK": {"Kasikorn", "KASITHBK", []string{"Kasikorn Bank", "K Bank"}},
+	"KTB":   {"Krungthai", "KRTHTHBK", []string{"Krung Thai"}},
+	"TMB":   {"ttb", "TMBKTHBK", []string{"TMBThanachart", "ttb bank"}},
+	"SCB":   {"SCB", "SICOTHBK", []string{"Siam Commercial"}},
+	"CIMB":  {"CIMB Thai", "UBOBTHBK", nil},
+	"UOB":   {"UOB", "UOVBTHBK", nil},
+	"BAY":   {"Krungsri", "AYUDTHBK", []string{"Bank of Ayudhya"}},
+	"GSB":   {"GSB", "GSBATHBK", []string{"Government Savings Bank"}},
+	"GHB":   {"GHB", "GOHUTHB1", nil},
+	"BAAC":  {"BAAC", "BAABTHBK", nil},
+	"TISCO": {"TISCO", "TFPCTHB1", nil},
+	"KKP":   {"Kiatnakin Phatra", "KKPBTHBK", nil},
+	"LHB":   {"LH Bank", "", nil},
+}
+
+// bankRegistry indexes banks by currency and normalized code, name, and alias.
+var bankRegistry = newBankRegistry()
+
+// registry holds the banks added with [RegisterBank] and the metadata of the
+// built-in banks. The built-in maps such as [BanksIDR] stay the source of
+// truth for which built-in codes exist and what they are called.
+type registry struct {
+	mu     sync.RWMutex
+	banks  map[Currency]map[string]Bank
+	index  map[Currency]map[string]string
+	custom map[Currency]map[string]bool
+}
+
+// builtinBanks returns the exported bank map for a currency.
+func builtinBanks(currency Currency) map[string]string {
 	switch currency {
 	case CurrencyIDR:
-		return BanksIDR[bankCode]
+		return BanksIDR
 	case CurrencyMYR:
-		return BanksMYR[bankCode]
+		return BanksMYR
 	case CurrencyTHB:
-		return BanksTHB[bankCode]
+		return BanksTHB
 	default:
-		return ""
+		return nil
 	}
 }
 
-// GetBankCodes returns all bank codes for a given currency.
-func GetBankCodes(currency Currency) []string {
-	var banks map[string]string
+// builtinBank builds the [Bank] for a code of a built-in bank map.
+func builtinBank(currency Currency, code, name string) Bank {
+	var (
+		meta  bankMeta
+		rules map[string]AccountRule
+	)
 	switch currency {
 	case CurrencyIDR:
-		banks = BanksIDR
+		meta, rules = bankMetaIDR[code], AccountRulesIDR
 	case CurrencyMYR:
-		banks = BanksMYR
+		meta, rules = bankMetaMYR[code], AccountRulesMYR
 	case CurrencyTHB:
-		banks = BanksTHB
-	default:
-		return nil
+		meta, rules = bankMetaTHB[code], AccountRulesTHB
+	}
+	b := Bank{
+		Code:        code,
+		Name:        name,
+		ShortName:   meta.shortName,
+		Type:        BankTypeBank,
+		Currency:    currency,
+		SWIFT:       meta.swift,
+		Payout:      true,
+		Aliases:     meta.aliases,
+		AccountRule: rules[code],
+	}
+	if len(b.AccountRule.PhonePrefixes) > 0 {
+		b.Type = BankTypeEWallet
+	}
+	return b
+}
+
+// newBankRegistry seeds the registry from the built-in bank maps.
+func newBankRegistry() *registry {
+	r := &registry{
+		banks:  make(map[Currency]map[string]Bank),
+		index:  make(map[Currency]map[string]string),
+		custom: make(map[Currency]map[string]bool),
+	}
+	for _, currency := range []Currency{CurrencyIDR, CurrencyMYR, CurrencyTHB} {
+		for code, name := range builtinBanks(currency) {
+			r.register(builtinBank(currency, code, name))
+		}
+	}
+	return r
+}
+
+// get returns the bank with exactly the given code. Banks added with
+// [RegisterBank] win; built-in banks are read from the exported maps, so
+// codes added to or removed from [BanksIDR] after start-up are honored.
+// The caller must hold r.mu.
+func (r *registry) get(currency Currency, code string) (Bank, bool) {
+	if r.custom[currency][code] {
+		return r.banks[currency][code], true
+	}
+	name, ok := builtinBanks(currency)[code]
+	if !ok {
+		return Bank{}, false
+	}
+	b, ok := r.banks[currency][code]
+	if !ok {
+		b = builtinBank(currency, code, name)
+	}
+	b.Name = name
+	return b, true
+}
+
+// register adds or replaces b. The caller must hold r.mu.
+func (r *registry) register(b Bank) {
+	if old, ok := r.banks[b.Currency][b.Code]; ok {
+		for key, code := range r.index[b.Currency] {
+			if code == old.Code {
+				delete(r.index[b.Currency], key)
+			}
+		}
+	}
+	if r.banks[b.Currency] == nil {
+		r.banks[b.Currency] = make(map[string]Bank)
+		r.index[b.Currency] = make(map[string]string)
 	}
+	r.banks[b.Currency][b.Code] = b
 
-	codes := make([]string, 0, len(banks))
-	for code := range banks {
-		codes = append(codes, code)
+	// Names and aliases never shadow another bank's code.
+	for _, name := range append([]string{b.Name, b.ShortName}, b.Aliases...) {
+		key := normalizeBankName(name)
+		if key == "" {
+			continue
+		}
+		if _, isCode := r.banks[b.Currency][key]; isCode && key != b.Code {
+			continue
+		}
+		r.index[b.Currency][key] = b.Code
+	}
+	r.index[b.Currency][b.Code] = b.Code
+}
+
+// RegisterBank adds a custom bank or replaces a built-in one for lookups,
+// listings, and validation. Code is upper-cased. It panics if Code or
+// Currency is empty.
+//
+// Example:
+//
+//	constants.RegisterBank(constants.Bank{
+//	    Code:     "JAGO",
+//	    Name:     "BANK JAGO",
+//	    Type:     constants.BankTypeBank,
+//	    Currency: constants.CurrencyIDR,
+//	    Payout:   true,
+//	    AccountRule: constants.AccountRule{MinLength: 12, MaxLength: 12},
+//	})
+func RegisterBank(b Bank) {
+	b.Code = strings.ToUpper(strings.TrimSpace(b.Code))
+	if b.Code == "" || b.Currency == "" {
+		panic("constants: RegisterBank requires a code and currency")
+	}
+	if b.Type == "" {
+		b.Type = BankTypeBank
+	}
+	bankRegistry.mu.Lock()
+	defer bankRegistry.mu.Unlock()
+	bankRegistry.register(b)
+	if bankRegistry.custom[b.Currency] == nil {
+		bankRegistry.custom[b.Currency] = make(map[string]bool)
+	}
+	bankRegistry.custom[b.Currency][b.Code] = true
+}
+
+// LookupBank finds a bank by code, name, short name, or alias, ignoring case,
+// extra spaces, and a leading "Bank", so "Mandiri" and "bank mandiri" both
+// return MANDIRI.
+func LookupBank(currency Currency, nameOrCode string) (Bank, bool) {
+	key := normalizeBankName(nameOrCode)
+	bankRegistry.mu.RLock()
+	defer bankRegistry.mu.RUnlock()
+
+	index := bankRegistry.index[currency]
+	code, ok := index[key]
+	if !ok {
+		if trimmed, cut := strings.CutPrefix(key, "BANK "); cut {
+			code, ok = index[trimmed]
+		}
+	}
+	if !ok {
+		code = key
+	}
+	return bankRegistry.get(currency, code)
+}
+
+// Banks returns all registered banks for a currency, sorted by code.
+func Banks(currency Currency) []Bank {
+	bankRegistry.mu.RLock()
+	defer bankRegistry.mu.RUnlock()
+
+	builtin := builtinBanks(currency)
+	banks := make([]Bank, 0, len(builtin)+len(bankRegistry.custom[currency]))
+	for code := range builtin {
+		if !bankRegistry.custom[currency][code] {
+			b, _ := bankRegistry.get(currency, code)
+			banks = append(banks, b)
+		}
+	}
+	for code := range bankRegistry.custom[currency] {
+		banks = append(banks, bankRegistry.banks[currency][code])
+	}
+	slices.SortFunc(banks, func(a, b Bank) int { return strings.Compare(a.Code, b.Code) })
+	return banks
+}
+
+// getBank returns the registered bank with exactly the given code.
+func getBank(bankCode string, currency Currency) (Bank, bool) {
+	bankRegistry.mu.RLock()
+	defer bankRegistry.mu.RUnlock()
+	return bankRegistry.get(currency, bankCode)
+}
+
+// normalizeBankName upper-cases s and collapses whitespace and dots.
+func normalizeBankName(s string) string {
+	s = strings.ReplaceAll(strings.ToUpper(s), ".", " ")
+	return strings.Join(strings.Fields(s), " ")
+}
+
+// GetBankName returns the bank name for a given bank code and currency.
+// Returns an empty string if the bank code is not found.
+func GetBankName(bankCode string, currency Currency) string {
+	b, _ := getBank(bankCode, currency)
+	return b.Name
+}
+
+// GetBankCodes returns all bank codes for a given currency, sorted.
+func GetBankCodes(currency Currency) []string {
+	banks := Banks(currency)
+	if len(banks) == 0 {
+		return nil
+	}
+	codes := make([]string, len(banks))
+	for i, b := range banks {
+		codes[i] = b.Code
 	}
 	return codes
 }
 
+// IsValidBank checks if a bank code is registered for a currency.
+func IsValidBank(bankCode string, currency Currency) bool {
+	_, ok := getBank(bankCode, currency)
+	return ok
+}
+
 // IsValidBankIDR checks if a bank code is valid for Indonesian banks.
 func IsValidBankIDR(bankCode string) bool {
-	_, ok := BanksIDR[bankCode]
-	return ok
+	return IsValidBank(bankCode, CurrencyIDR)
+}
+
+// IsValidBankMYR checks if a bank code is valid for Malaysian banks.
+func IsValidBankMYR(bankCode string) bool {
+	return IsValidBank(bankCode, CurrencyMYR)
+}
+
+// IsValidBankTHB checks if a bank code is valid for Thai banks.
+func IsValidBankTHB(bankCode string) bool {
+	return IsValidBank(bankCode, CurrencyTHB)
 }
@@ -15,9 +15,12 @@
 package constants
 
 import (
+	"maps"
+	"slices"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestGetBankName(t *testing.T) {
@@ -89,3 +92,147 @@ func TestIsValidBankIDR(t *testing.T) {
 		assert.False(t, IsValidBankIDR("bca")) // case-sensitive
 	})
 }
+
+func TestBanks(t *testing.T) {
+	t.Run("sorted by code", func(t *testing.T) {
+		for _, currency := range []Currency{CurrencyIDR, CurrencyMYR, CurrencyTHB} {
+			codes := GetBankCodes(currency)
+			assert.True(t, slices.IsSorted(codes), currency)
+			assert.Len(t, Banks(currency), len(codes))
+		}
+	})
+
+	t.Run("unknown currency", func(t *testing.T) {
+		assert.Empty(t, Banks(CurrencyUSDT))
+		assert.Nil(t, GetBankCodes(CurrencyUSDT))
+	})
+
+	t.Run("metadata", func(t *testing.T) {
+		b, ok := LookupBank(CurrencyIDR, "BCA")
+		require.True(t, ok)
+		assert.Equal(t, "Bank BCA", b.Name)
+		assert.Equal(t, "CENAIDJA", b.SWIFT)
+		assert.Equal(t, BankTypeBank, b.Type)
+		assert.Equal(t, CurrencyIDR, b.Currency)
+		assert.True(t, b.Payout)
+
+		dana, ok := LookupBank(CurrencyIDR, "DANA")
+		require.True(t, ok)
+		assert.Equal(t, BankTypeEWallet, dana.Type)
+		assert.Empty(t, dana.SWIFT)
+
+		tng, ok := LookupBank(CurrencyMYR, "TNG")
+		require.True(t, ok)
+		assert.Equal(t, BankTypeEWallet, tng.Type)
+	})
+}
+
+func TestLookupBank(t *testing.T) {
+	tests := []struct {
+		currency Currency
+		query    string
+		want     string
+	}{
+		{CurrencyIDR, "MANDIRI", "MANDIRI"},
+		{CurrencyIDR, "Mandiri", "MANDIRI"},
+		{CurrencyIDR, "bank mandiri", "MANDIRI"},
+		{CurrencyIDR, "  Bank   Mandiri ", "MANDIRI"},
+		{CurrencyIDR, "bca", "BCA"},
+		{CurrencyIDR, "Bank Central Asia", "BCA"},
+		{CurrencyIDR, "bank bri", "BRI"},
+		{CurrencyIDR, "CIMB Niaga", "CIMB"},
+		{CurrencyMYR, "maybank", "MBB"},
+		{CurrencyMYR, "Touch n Go", "TNG"},
+		{CurrencyTHB, "krungsri", "BAY"},
+		{CurrencyTHB, "K. Bank", "KBANK"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			b, ok := LookupBank(tt.currency, tt.query)
+			require.True(t, ok)
+			assert.Equal(t, tt.want, b.Code)
+		})
+	}
+
+	t.Run("not found", func(t *testing.T) {
+		_, ok := LookupBank(CurrencyIDR, "Maybank")
+		assert.False(t, ok)
+		_, ok = LookupBank(CurrencyIDR, "")
+		assert.False(t, ok)
+	})
+}
+
+func TestBanksIDRChanges(t *testing.T) {
+	orig := maps.Clone(BanksIDR)
+	t.Cleanup(func() { BanksIDR = orig })
+
+	BanksIDR = maps.Clone(orig)
+	BanksIDR["JAGO"] = "BANK JAGO"
+	BanksIDR["BCA"] = "BANK CENTRAL ASIA"
+	delete(BanksIDR, "OVO")
+
+	assert.True(t, IsValidBankIDR("JAGO"))
+	assert.Equal(t, "BANK JAGO", GetBankName("JAGO", CurrencyIDR))
+	assert.Equal(t, "BANK CENTRAL ASIA", GetBankName("BCA", CurrencyIDR))
+	assert.False(t, IsValidBankIDR("OVO"))
+	assert.Empty(t, GetBankName("OVO", CurrencyIDR))
+	assert.Contains(t, GetBankCodes(CurrencyIDR), "JAGO")
+	assert.NotContains(t, GetBankCodes(CurrencyIDR), "OVO")
+
+	b, ok := LookupBank(CurrencyIDR, "jago")
+	require.True(t, ok)
+	assert.Equal(t, "BANK JAGO", b.Name)
+	_, ok = LookupBank(CurrencyIDR, "OVO")
+	assert.False(t, ok)
+}
+
+func TestRegisterBank(t *testing.T) {
+	t.Cleanup(func() { bankRegistry = newBankRegistry() })
+
+	t.Run("custom bank", func(t *testing.T) {
+		RegisterBank(Bank{
+			Code:        "jago",
+			Name:        "BANK JAGO",
+			Currency:    CurrencyIDR,
+			Payout:      true,
+			Aliases:     []string{"Jago"},
+			AccountRule: AccountRule{MinLength: 12, MaxLength: 12},
+		})
+
+		b, ok := LookupBank(CurrencyIDR, "bank jago")
+		require.True(t, ok)
+		assert.Equal(t, "JAGO", b.Code)
+		assert.Equal(t, BankTypeBank, b.Type)
+		assert.True(t, IsValidBankIDR("JAGO"))
+		assert.Contains(t, GetBankCodes(CurrencyIDR), "JAGO")
+		assert.Equal(t, "BANK JAGO", GetBankName("JAGO", CurrencyIDR))
+		assert.NoError(t, ValidateAccountNumber(CurrencyIDR, "JAGO", "123456789012"))
+		assert.Error(t, ValidateAccountNumber(CurrencyIDR, "JAGO", "1234567890"))
+	})
+
+	t.Run("override replaces aliases", func(t *testing.T) {
+		RegisterBank(Bank{Code: "BCA", Name: "BCA DIGITAL", Currency: CurrencyIDR, Aliases: []string{"blu"}})
+
+		b, ok := LookupBank(CurrencyIDR, "blu")
+		require.True(t, ok)
+		assert.Equal(t, "BCA", b.Code)
+		assert.False(t, b.Payout)
+
+		_, ok = LookupBank(CurrencyIDR, "Bank Central Asia")
+		assert.False(t, ok)
+		assert.Equal(t, DefaultAccountRule, GetAccountRule("BCA", CurrencyIDR))
+	})
+
+	t.Run("requires code and currency", func(t *testing.T) {
+		assert.Panics(t, func() { RegisterBank(Bank{Currency: CurrencyIDR}) })
+		assert.Panics(t, func() { RegisterBank(Bank{Code: "X"}) })
+	})
+}
+
+func TestIsValidBank(t *testing.T) {
+	assert.True(t, IsValidBankMYR("MBB"))
+	assert.False(t, IsValidBankMYR("BCA"))
+	assert.True(t, IsValidBankTHB("KBANK"))
+	assert.False(t, IsValidBankTHB("kbank"))
+	assert.True(t, IsValidBank("CIMB", CurrencyMYR))
+}
@@ -14,6 +14,13 @@
 
 package constants
 
+import (
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
 // ChannelIDR represents available payment channels for Indonesia.
 type ChannelIDR string
 
@@ -33,8 +40,134 @@ var ChannelsIDR = map[ChannelIDR]string{
 	ChannelBNI:  "BNI Virtual Account",
 }
 
-// IsValidChannelIDR checks if a channel is valid for Indonesian payments.
+// ChannelType classifies how the user pays through a channel.
+type ChannelType string
+
+// Channel types.
+const (
+	// ChannelTypeQR is a QR code payment.
+	ChannelTypeQR ChannelType = "qr"
+	// ChannelTypeEWallet is an e-wallet payment.
+	ChannelTypeEWallet ChannelType = "ewallet"
+	// ChannelTypeVirtualAccount is a bank virtual account transfer.
+	ChannelTypeVirtualAccount ChannelType = "virtual_account"
+)
+
+// Channel describes the capabilities of an IDR payment channel.
+//
+// The built-in amount limits are the usual limits of each channel; the limits
+// of a GSPAY2 operator account may be lower. Override them with
+// [RegisterChannel] if needed.
+type Channel struct {
+	// Code is the channel code sent to GSPAY2.
+	Code ChannelIDR
+	// Name is the display name.
+	Name string
+	// Type is the channel type.
+	Type ChannelType
+	// MinAmount is the minimum amount in IDR. Zero means [MinAmountIDR].
+	MinAmount int64
+	// MaxAmount is the maximum amount in IDR. Zero means no limit.
+	MaxAmount int64
+	// Expiry is the expected time until an unpaid order expires.
+	Expiry time.Duration
+	// QR reports whether the create response carries QR data.
+	QR bool
+	// PaymentURL reports whether the create response carries a payment URL.
+	PaymentURL bool
+}
+
+// AmountRange returns the effective minimum and maximum amount. A maximum of
+// zero means no limit.
+func (ch Channel) AmountRange() (minAmount, maxAmount int64) {
+	minAmount = ch.MinAmount
+	if minAmount == 0 {
+		minAmount = MinAmountIDR
+	}
+	return minAmount, ch.MaxAmount
+}
+
+// channelRegistry holds the registered IDR payment channels.
+var channelRegistry = struct {
+	mu       sync.RWMutex
+	channels map[ChannelIDR]Channel
+}{channels: map[ChannelIDR]Channel{
+	ChannelQRIS: {
+		Code: ChannelQRIS, Name: ChannelsIDR[ChannelQRIS], Type: ChannelTypeQR,
+		MaxAmount: 10_000_000, Expiry: 15 * time.Minute, QR: true, PaymentURL: true,
+	},
+	ChannelDANA: {
+		Code: ChannelDANA, Name: ChannelsIDR[ChannelDANA], Type: ChannelTypeEWallet,
+		MaxAmount: 20_000_000, Expiry: 15 * time.Minute, PaymentURL: true,
+	},
+	ChannelBNI: {
+		Code: ChannelBNI, Name: ChannelsIDR[ChannelBNI], Type: ChannelTypeVirtualAccount,
+		MaxAmount: 50_000_000, Expiry: 15 * time.Minute, PaymentURL: true,
+	},
+}}
+
+// RegisterChannel adds a payment channel or replaces a built-in one, so a
+// channel GSPAY2 enables can be used without an SDK release. Code is
+// upper-cased. It panics if Code is empty.
+//
+// Example:
+//
+//	constants.RegisterChannel(constants.Channel{
+//	    Code:       "OVO",
+//	    Name:       "OVO E-Wallet",
+//	    Type:       constants.ChannelTypeEWallet,
+//	    MaxAmount:  10_000_000,
+//	    Expiry:     15 * time.Minute,
+//	    PaymentURL: true,
+//	})
+func RegisterChannel(ch Channel) {
+	ch.Code = normalizeChannel(ch.Code)
+	if ch.Code == "" {
+		panic("constants: RegisterChannel requires a code")
+	}
+	channelRegistry.mu.Lock()
+	defer channelRegistry.mu.Unlock()
+	channelRegistry.channels[ch.Code] = ch
+}
+
+// UnregisterChannel removes a payment channel, for example one GSPAY2 has
+// disabled for the operator account.
+func UnregisterChannel(code ChannelIDR) {
+	channelRegistry.mu.Lock()
+	defer channelRegistry.mu.Unlock()
+	delete(channelRegistry.channels, normalizeChannel(code))
+}
+
+// LookupChannel returns the registered channel for code, ignoring case and
+// surrounding spaces.
+func LookupChannel(code ChannelIDR) (Channel, bool) {
+	channelRegistry.mu.RLock()
+	defer channelRegistry.mu.RUnlock()
+	ch, ok := channelRegistry.channels[normalizeChannel(code)]
+	return ch, ok
+}
+
+// normalizeChannel upper-cases code and trims surrounding spaces.
+func normalizeChannel(code ChannelIDR) ChannelIDR {
+	return ChannelIDR(strings.ToUpper(strings.TrimSpace(string(code))))
+}
+
+// Channels returns all registered IDR payment channels, sorted by code.
+func Channels() []Channel {
+	channelRegistry.mu.RLock()
+	defer channelRegistry.mu.RUnlock()
+
+	channels := make([]Channel, 0, len(channelRegistry.channels))
+	for _, ch := range channelRegistry.channels {
+		channels = append(channels, ch)
+	}
+	slices.SortFunc(channels, func(a, b Channel) int { return strings.Compare(string(a.Code), string(b.Code)) })
+	return channels
+}
+
+// IsValidChannelIDR checks if a channel is registered for Indonesian payments,
+// ignoring case and surrounding spaces like [LookupChannel].
 func IsValidChannelIDR(channel ChannelIDR) bool {
-	_, ok := ChannelsIDR[channel]
+	_, ok := LookupChannel(channel)
 	return ok
 }
@@ -16,8 +16,10 @@ package constants
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestIsValidChannelIDR(t *testing.T) {
@@ -33,6 +35,14 @@ func TestIsValidChannelIDR(t *testing.T) {
 		}
 	})
 
+	t.Run("ignores case like LookupChannel", func(t *testing.T) {
+		for _, channel := range []ChannelIDR{"qris", "Dana", " bni "} {
+			_, ok := LookupChannel(channel)
+			assert.True(t, ok, "channel %q should be found", channel)
+			assert.True(t, IsValidChannelIDR(channel), "channel %q should be valid", channel)
+		}
+	})
+
 	t.Run("returns false for invalid channels", func(t *testing.T) {
 		invalidChannels := []ChannelIDR{
 			"INVALID",
@@ -45,3 +55,52 @@ func TestIsValidChannelIDR(t *testing.T) {
 		}
 	})
 }
+
+func TestChannels(t *testing.T) {
+	t.Run("sorted with metadata", func(t *testing.T) {
+		channels := Channels()
+		require.Len(t, channels, 3)
+		assert.Equal(t, []ChannelIDR{ChannelBNI, ChannelDANA, ChannelQRIS},
+			[]ChannelIDR{channels[0].Code, channels[1].Code, channels[2].Code})
+
+		qris, ok := LookupChannel("qris")
+		require.True(t, ok)
+		assert.Equal(t, ChannelTypeQR, qris.Type)
+		assert.True(t, qris.QR)
+		assert.Equal(t, 15*time.Minute, qris.Expiry)
+
+		bni, _ := LookupChannel(ChannelBNI)
+		assert.Equal(t, ChannelTypeVirtualAccount, bni.Type)
+		assert.False(t, bni.QR)
+		assert.True(t, bni.PaymentURL)
+	})
+
+	t.Run("amount range", func(t *testing.T) {
+		minAmount, maxAmount := Channel{MaxAmount: 100}.AmountRange()
+		assert.Equal(t, int64(MinAmountIDR), minAmount)
+		assert.Equal(t, int64(100), maxAmount)
+
+		minAmount, _ = Channel{MinAmount: 50000}.AmountRange()
+		assert.Equal(t, int64(50000), minAmount)
+	})
+}
+
+func TestRegisterChannel(t *testing.T) {
+	qris, _ := LookupChannel(ChannelQRIS)
+	t.Cleanup(func() {
+		UnregisterChannel("OVO")
+		RegisterChannel(qris)
+	})
+
+	RegisterChannel(Channel{Code: "ovo", Name: "OVO E-Wallet", Type: ChannelTypeEWallet})
+	assert.True(t, IsValidChannelIDR("OVO"))
+	ovo, ok := LookupChannel("Ovo")
+	require.True(t, ok)
+	assert.Equal(t, "OVO E-Wallet", ovo.Name)
+	assert.Len(t, Channels(), 4)
+
+	UnregisterChannel(ChannelQRIS)
+	assert.False(t, IsValidChannelIDR(ChannelQRIS))
+
+	assert.Panics(t, func() { RegisterChannel(Channel{}) })
+}
@@ -14,17 +14,27 @@
 
 package constants
 
+import "time"
+
 // DefaultBaseURL is the default GSPAY2 API base URL.
 const DefaultBaseURL = "https://api.thegspay.com"
 
 // Default client configuration values.
 const (
 	DefaultTimeout      = 30 // seconds
+	MinTimeout          = 5  // seconds
 	DefaultRetries      = 3
 	DefaultRetryWaitMin = 500  // milliseconds
 	DefaultRetryWaitMax = 2000 // milliseconds
+
+	DefaultMaxResponseSize = 1 << 20  // bytes
+	DefaultMaxCallbackSize = 64 << 10 // bytes
 )
 
+// DefaultServerLocation is the time zone GSPAY2 uses for dates without an
+// explicit offset: Western Indonesia Time (WIB, UTC+7).
+var DefaultServerLocation = time.FixedZone("WIB", 7*60*60)
+
 // Minimum amount constraints.
 const (
 	MinAmountIDR  = 10000 // Minimum IDR amount
@@ -36,3 +46,6 @@ const (
 	MinTransactionIDLength = 5
 	MaxTransactionIDLength = 20
 )
+
+// MaxDescriptionLength is the maximum payout description length in characters.
+const MaxDescriptionLength = 255
@@ -39,14 +39,36 @@
 //   - [ChannelDANA]: DANA e-wallet
 //   - [ChannelBNI]: BNI Virtual Account
 //
+// Each channel is described by a [Channel] with its type, amount limits,
+// expiry window, and response shape. Use [LookupChannel] and [Channels] to
+// read them, and [RegisterChannel] to add channels at runtime.
+//
 // # Bank Codes
 //
-// Bank code constants and lookup functions:
+// Banks are kept in a registry of [Bank] values with display names, type,
+// SWIFT/BIC, payout support, and aliases. Lookup functions:
+//   - [LookupBank]: Find a bank by code, name, or alias, ignoring case
+//   - [Banks]: List banks for a currency, sorted by code
+//   - [RegisterBank]: Add a custom bank or override a built-in one
 //   - [IsValidBankIDR]: Check if bank code is valid for IDR
 //   - [IsValidBankMYR]: Check if bank code is valid for MYR
 //   - [IsValidBankTHB]: Check if bank code is valid for THB
 //   - [GetBankName]: Get bank name from code
-//   - [GetBankCodes]: List all bank codes for a currency
+//   - [GetBankCodes]: List all bank codes for a currency, sorted
+//
+// The [BanksIDR], [BanksMYR] and [BanksTHB] maps are read on every lookup,
+// so codes and names added to or removed from them at runtime are honored.
+// Banks added with [RegisterBank] take precedence over the maps.
+//
+// # Account Numbers
+//
+// [AccountRulesIDR], [AccountRulesMYR] and [AccountRulesTHB] describe the
+// account number format of each bank: digit count, and for e-wallets such as
+// DANA, OVO and TNG, the mobile number prefixes. [ValidateAccountNumber]
+// checks a number against its bank's rule:
+//
+//	err := constants.ValidateAccountNumber(constants.CurrencyIDR, "BCA", "123456789")
+//	// account number for BCA must be 10 digits
 //
 // # API Endpoints
 //
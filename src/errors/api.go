@@ -34,6 +34,11 @@ type APIError struct {
 	RawResponse string `json:"-"`
 	// Lang is the language for error messages.
 	Lang i18n.Language `json:"-"`
+	// Err is the catalog sentinel for a known business failure, if any.
+	// See [LookupBusinessError].
+	Err error `json:"-"`
+
+	explanation i18n.MessageKey
 }
 
 // Error implements the error interface.
@@ -47,6 +52,21 @@ func (e *APIError) Error() string {
 	return fmt.Sprintf(format, e.Code, e.Message)
 }
 
+// Is reports whether target is the catalog sentinel of this error, so
+// errors.Is(err, ErrDuplicateTransaction) works on API errors.
+func (e *APIError) Is(target error) bool {
+	return e.Err != nil && e.Err == target
+}
+
+// Explanation returns a localized explanation of a known business failure,
+// or an empty string if the error is not in the catalog.
+func (e *APIError) Explanation() string {
+	if e.explanation == "" {
+		return ""
+	}
+	return i18n.Get(e.Lang, e.explanation)
+}
+
 // IsAPIError checks if an error is an APIError.
 func IsAPIError(err error) bool {
 	var apiErr *APIError
@@ -0,0 +1,226 @@
+// Copyright 2026 H0llyW00dzZ
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package errors
+
+import (
+	"errors"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/H0llyW00dzZ/gspay-go-sdk/src/i18n"
+)
+
+// BusinessError describes a known GSPAY2 business failure, returned as a
+// non-200 code in the response body of an HTTP 200 reply.
+//
+// GSPAY2 reuses a handful of codes for unrelated failures, so entries usually
+// match on the message text as well as the code.
+type BusinessError struct {
+	// Code is the response body code to match. Zero matches any code.
+	Code int
+	// Contains lists message phrases, matched case-insensitively on word
+	// boundaries, so "offline" does not match "offline_mode". Any one phrase
+	// is enough. An empty list matches any message.
+	Contains []string
+	// Err is the sentinel an [APIError] matching this entry reports via [errors.Is].
+	Err error
+	// Explanation is the i18n key for a user-facing explanation and next step.
+	Explanation i18n.MessageKey
+}
+
+// matches reports whether the entry applies to code and message.
+func (b BusinessError) matches(code int, message string) bool {
+	if b.Code != 0 && b.Code != code {
+		return false
+	}
+	if len(b.Contains) == 0 {
+		return true
+	}
+	message = strings.ToLower(message)
+	for _, phrase := range b.Contains {
+		if containsPhrase(message, strings.ToLower(phrase)) {
+			return true
+		}
+	}
+	return false
+}
+
+// containsPhrase reports whether phrase occurs in message as whole words.
+func containsPhrase(message, phrase string) bool {
+	if phrase == "" {
+		return false
+	}
+	for offset := 0; ; {
+		i := strings.Index(message[offset:], phrase)
+		if i < 0 {
+			return false
+		}
+		start := offset + i
+		end := start + len(phrase)
+		before, _ := utf8.DecodeLastRuneInString(message[:start])
+		after, _ := utf8.DecodeRuneInString(message[end:])
+		if (start == 0 || !isWordRune(before)) && (end == len(message) || !isWordRune(after)) {
+			return true
+		}
+		offset = start + 1
+	}
+}
+
+// isWordRune reports whether r is part of a word.
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_'
+}
+
+// builtinBusinessErrors are the business failures every [BusinessCatalog]
+// starts with.
+var builtinBusinessErrors = []BusinessError{
+	{
+		Contains:    []string{"duplicate", "already exists", "already exist", "already used", "sudah ada", "sudah digunakan"},
+		Err:         ErrDuplicateTransaction,
+		Explanation: i18n.MsgExplainDuplicateTransaction,
+	},
+	{
+		Contains:    []string{"invalid signature", "signature mismatch", "wrong signature", "signature tidak valid", "tanda tangan tidak valid", "tanda tangan salah"},
+		Err:         ErrInvalidSignature,
+		Explanation: i18n.MsgExplainInvalidSignature,
+	},
+	{
+		Contains:    []string{"insufficient balance", "insufficient funds", "not enough balance", "saldo tidak cukup", "saldo tidak mencukupi"},
+		Err:         ErrInsufficientBalance,
+		Explanation: i18n.MsgExplainInsufficientBalance,
+	},
+	{
+		Contains:    []string{"maintenance", "pemeliharaan", "bank offline", "bank is offline"},
+		Err:         ErrBankMaintenance,
+		Explanation: i18n.MsgExplainBankMaintenance,
+	},
+	{
+		Contains:    []string{"account name", "name mismatch", "account mismatch", "nama rekening", "rekening tidak sesuai", "nama tidak sesuai"},
+		Err:         ErrAccountMismatch,
+		Explanation: i18n.MsgExplainAccountMismatch,
+	},
+}
+
+// BusinessCatalog is an ordered set of [BusinessError] entries used to
+// recognize business failures. A client uses [DefaultBusinessCatalog] unless
+// another catalog is set with client.WithBusinessCatalog, so entries can be
+// scoped to one client. A BusinessCatalog is safe for concurrent use.
+type BusinessCatalog struct {
+	mu      sync.RWMutex
+	entries []BusinessError
+}
+
+// NewBusinessCatalog creates a catalog holding the built-in entries followed
+// by entries. Use [BusinessCatalog.Register] to add entries that take
+// precedence over the built-in ones.
+func NewBusinessCatalog(entries ...BusinessError) *BusinessCatalog {
+	return &BusinessCatalog{entries: append(slices.Clone(builtinBusinessErrors), entries...)}
+}
+
+// Register adds an entry that is checked before the existing ones, so it can
+// also override them.
+//
+// Example:
+//
+//	catalog := errors.NewBusinessCatalog()
+//	catalog.Register(errors.BusinessError{
+//	    Code:        409,
+//	    Err:         errors.ErrDuplicateTransaction,
+//	    Explanation: i18n.MsgExplainDuplicateTransaction,
+//	})
+//	c := client.New("auth", "secret", client.WithBusinessCatalog(catalog))
+func (bc *BusinessCatalog) Register(entry BusinessError) {
+	bc.mu.Lock()
+	defer bc.mu.Unlock()
+	bc.entries = append([]BusinessError{entry}, bc.entries...)
+}
+
+// Lookup returns the entry matching code and message. Entries for this exact
+// code are checked first, then entries that match any code, each in order.
+func (bc *BusinessCatalog) Lookup(code int, message string) (BusinessError, bool) {
+	bc.mu.RLock()
+	defer bc.mu.RUnlock()
+	for _, exact := range []bool{true, false} {
+		for _, entry := range bc.entries {
+			if (entry.Code != 0) == exact && entry.matches(code, message) {
+				return entry, true
+			}
+		}
+	}
+	return BusinessError{}, false
+}
+
+// NewError builds an [APIError] for a business failure reported in the
+// response body, attaching the matching sentinel if any.
+func (bc *BusinessCatalog) NewError(lang i18n.Language, code int, message, endpoint, raw string) *APIError {
+	apiErr := &APIError{
+		Code:        code,
+		Message:     message,
+		Endpoint:    endpoint,
+		RawResponse: raw,
+		Lang:        lang,
+	}
+	if entry, ok := bc.Lookup(code, message); ok {
+		apiErr.Err = entry.Err
+		apiErr.explanation = entry.Explanation
+	}
+	return apiErr
+}
+
+// DefaultBusinessCatalog is the catalog used by clients without
+// client.WithBusinessCatalog.
+var DefaultBusinessCatalog = NewBusinessCatalog()
+
+// RegisterBusinessError adds an entry to [DefaultBusinessCatalog].
+//
+// Deprecated: This changes the catalog of every client in the process. Use
+// [BusinessCatalog.Register] on a catalog passed to client.WithBusinessCatalog.
+func RegisterBusinessError(entry BusinessError) {
+	DefaultBusinessCatalog.Register(entry)
+}
+
+// LookupBusinessError returns the [DefaultBusinessCatalog] entry matching
+// code and message.
+func LookupBusinessError(code int, message string) (BusinessError, bool) {
+	return DefaultBusinessCatalog.Lookup(code, message)
+}
+
+// NewBusinessError builds an [APIError] for a business failure reported in
+// the response body, using [DefaultBusinessCatalog].
+func NewBusinessError(lang i18n.Language, code int, message, endpoint, raw string) *APIError {
+	return DefaultBusinessCatalog.NewError(lang, code, message, endpoint, raw)
+}
+
+// ClassifyBusiness classifies a business failure from its body code and
+// catalog sentinel (nil when the failure is not in the catalog).
+func ClassifyBusiness(code int, sentinel error) Classification {
+	switch {
+	case errors.Is(sentinel, ErrInsufficientBalance), errors.Is(sentinel, ErrBankMaintenance):
+		// Nothing was created; the same request may succeed later.
+		return Classification{Category: CategoryRejected, Retryable: true, MayHaveReachedServer: true}
+	case errors.Is(sentinel, ErrInvalidSignature):
+		return Classification{Category: CategoryAuth, MayHaveReachedServer: true}
+	case sentinel != nil:
+		return Classification{Category: CategoryRejected, MayHaveReachedServer: true}
+	case code == http.StatusNotFound:
+		// Unlike HTTP 404, a 404 body code is a definite answer, not a routing glitch.
+		return Classification{Category: CategoryRejected, MayHaveReachedServer: true}
+	}
+	return ClassifyStatus(code)
+}
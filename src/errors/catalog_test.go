@@ -0,0 +1,163 @@
+// Copyright 2026 H0llyW00dzZ
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package errors
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/H0llyW00dzZ/gspay-go-sdk/src/i18n"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLookupBusinessError(t *testing.T) {
+	tests := []struct {
+		name    string
+		code    int
+		message string
+		want    error
+	}{
+		{"duplicate", 400, "Duplicate transaction ID", ErrDuplicateTransaction},
+		{"already exists", 400, "transaction already exists", ErrDuplicateTransaction},
+		{"invalid signature", 401, "Invalid signature", ErrInvalidSignature},
+		{"insufficient balance", 400, "Insufficient balance", ErrInsufficientBalance},
+		{"indonesian balance", 400, "Saldo tidak mencukupi", ErrInsufficientBalance},
+		{"maintenance", 503, "Bank BCA is under maintenance", ErrBankMaintenance},
+		{"account mismatch", 400, "Account name mismatch", ErrAccountMismatch},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			entry, ok := LookupBusinessError(tt.code, tt.message)
+			require.True(t, ok)
+			assert.Equal(t, tt.want, entry.Err)
+			assert.NotEmpty(t, entry.Explanation)
+		})
+	}
+
+	t.Run("unknown message", func(t *testing.T) {
+		_, ok := LookupBusinessError(400, "invalid parameter")
+		assert.False(t, ok)
+	})
+}
+
+func TestBusinessCatalog(t *testing.T) {
+	t.Run("register is scoped to the catalog", func(t *testing.T) {
+		catalog := NewBusinessCatalog()
+		catalog.Register(BusinessError{Code: 409, Err: ErrDuplicateTransaction, Explanation: i18n.MsgExplainDuplicateTransaction})
+
+		entry, ok := catalog.Lookup(409, "conflict")
+		require.True(t, ok)
+		assert.Equal(t, ErrDuplicateTransaction, entry.Err)
+
+		_, ok = catalog.Lookup(400, "conflict")
+		assert.False(t, ok, "code must match")
+
+		_, ok = LookupBusinessError(409, "conflict")
+		assert.False(t, ok, "default catalog is unchanged")
+	})
+
+	t.Run("code-specific entries win", func(t *testing.T) {
+		catalog := NewBusinessCatalog(BusinessError{Code: 402, Contains: []string{"balance"}, Err: ErrInsufficientBalance})
+		// Registered later, so it comes first in order, but matches any code.
+		catalog.Register(BusinessError{Contains: []string{"balance"}, Err: ErrBankMaintenance})
+
+		entry, ok := catalog.Lookup(402, "balance locked")
+		require.True(t, ok)
+		assert.Equal(t, ErrInsufficientBalance, entry.Err)
+
+		entry, ok = catalog.Lookup(400, "balance locked")
+		require.True(t, ok)
+		assert.Equal(t, ErrBankMaintenance, entry.Err)
+	})
+
+	t.Run("broad words do not match", func(t *testing.T) {
+		for _, message := range []string{
+			"Jumlah tidak sesuai",
+			"Signature field is required",
+			"merchant offline_mode enabled",
+			"Transaction is being processed offline",
+			"duplicated_field_name is invalid",
+		} {
+			_, ok := LookupBusinessError(400, message)
+			assert.False(t, ok, message)
+		}
+	})
+
+	t.Run("phrases match on word boundaries", func(t *testing.T) {
+		for message, want := range map[string]error{
+			"Error: invalid signature.":      ErrInvalidSignature,
+			"BANK OFFLINE, try later":        ErrBankMaintenance,
+			"Nama rekening tidak sesuai":     ErrAccountMismatch,
+			"Insufficient balance (code 51)": ErrInsufficientBalance,
+			"transaction_id sudah digunakan": ErrDuplicateTransaction,
+		} {
+			entry, ok := LookupBusinessError(400, message)
+			require.True(t, ok, message)
+			assert.Equal(t, want, entry.Err, message)
+		}
+	})
+}
+
+func TestNewBusinessError(t *testing.T) {
+	t.Run("known failure", func(t *testing.T) {
+		err := NewBusinessError(i18n.Indonesian, 400, "Duplicate transaction ID", "/v2/integrations/operators/abc", "{}")
+		assert.True(t, errors.Is(err, ErrDuplicateTransaction))
+		assert.False(t, errors.Is(err, ErrInsufficientBalance))
+		assert.True(t, errors.Is(fmt.Errorf("wrapped: %w", err), ErrDuplicateTransaction))
+		assert.Equal(t, i18n.Get(i18n.Indonesian, i18n.MsgExplainDuplicateTransaction), err.Explanation())
+		assert.Contains(t, err.Error(), "Duplicate transaction ID")
+	})
+
+	t.Run("unknown failure", func(t *testing.T) {
+		err := NewBusinessError(i18n.English, 400, "invalid parameter", "", "")
+		assert.Nil(t, err.Err)
+		assert.Empty(t, err.Explanation())
+		assert.False(t, errors.Is(err, ErrDuplicateTransaction))
+	})
+}
+
+func TestClassifyBusiness(t *testing.T) {
+	tests := []struct {
+		name     string
+		code     int
+		sentinel error
+		want     Classification
+	}{
+		{"insufficient balance", 400, ErrInsufficientBalance,
+			Classification{Category: CategoryRejected, Retryable: true, MayHaveReachedServer: true}},
+		{"bank maintenance", 500, ErrBankMaintenance,
+			Classification{Category: CategoryRejected, Retryable: true, MayHaveReachedServer: true}},
+		{"invalid signature", 400, ErrInvalidSignature,
+			Classification{Category: CategoryAuth, MayHaveReachedServer: true}},
+		{"duplicate", 500, ErrDuplicateTransaction,
+			Classification{Category: CategoryRejected, MayHaveReachedServer: true}},
+		{"not found", 404, nil,
+			Classification{Category: CategoryRejected, MayHaveReachedServer: true}},
+		{"uncataloged server error", 500, nil,
+			Classification{Category: CategoryServer, Retryable: true, MayHaveReachedServer: true}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, ClassifyBusiness(tt.code, tt.sentinel))
+		})
+	}
+
+	t.Run("classify uses catalog sentinel", func(t *testing.T) {
+		err := NewBusinessError(i18n.English, 400, "Insufficient balance", "", "")
+		assert.Equal(t, Classification{Category: CategoryRejected, Retryable: true, MayHaveReachedServer: true}, Classify(err))
+	})
+}
@@ -0,0 +1,181 @@
+// Copyright 2026 H0llyW00dzZ
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package errors
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+)
+
+// Category groups errors by how a caller should react to them.
+type Category string
+
+// Error categories returned by [Classify].
+const (
+	// CategoryUnknown is an error the SDK cannot place.
+	CategoryUnknown Category = "unknown"
+	// CategoryValidation is invalid input detected before any request was sent.
+	CategoryValidation Category = "validation"
+	// CategoryAuth is an authentication, signature, or IP whitelist failure.
+	CategoryAuth Category = "auth"
+	// CategoryRateLimited is an HTTP 429 response.
+	CategoryRateLimited Category = "rate_limited"
+	// CategoryNetwork is a transport failure such as a refused connection or timeout.
+	CategoryNetwork Category = "network"
+	// CategoryServer is a server-side failure: HTTP 5xx, or an empty or malformed response.
+	CategoryServer Category = "server"
+	// CategoryRejected is a permanent business rejection returned by GSPAY2.
+	CategoryRejected Category = "rejected"
+	// CategoryCanceled is a canceled context or an expired deadline.
+	CategoryCanceled Category = "canceled"
+)
+
+// Classification describes how a caller should handle an error.
+type Classification struct {
+	// Category groups the error.
+	Category Category
+	// Retryable reports whether the same call may succeed if retried later.
+	Retryable bool
+	// MayHaveReachedServer reports whether GSPAY2 may have received the
+	// request. When true, check the transaction status before retrying a
+	// create call, so the same transaction is not submitted twice.
+	MayHaveReachedServer bool
+}
+
+// ClassifiedError attaches a [Classification] to an error. The SDK client
+// wraps every request failure in one, so [Classify] does not have to guess.
+type ClassifiedError struct {
+	Err   error
+	Class Classification
+}
+
+// Error implements the error interface.
+func (e *ClassifiedError) Error() string { return e.Err.Error() }
+
+// Unwrap returns the classified error.
+func (e *ClassifiedError) Unwrap() error { return e.Err }
+
+// WithClassification wraps err with class. It returns nil if err is nil.
+func WithClassification(err error, class Classification) error {
+	if err == nil {
+		return nil
+	}
+	return &ClassifiedError{Err: err, Class: class}
+}
+
+// Classify reports how a caller should handle err. It returns the zero
+// [Classification] for a nil error.
+//
+// Example:
+//
+//	switch c := errors.Classify(err); {
+//	case c.Category == errors.CategoryValidation:
+//	    // Show the message to the user
+//	case c.Retryable && !c.MayHaveReachedServer:
+//	    // Safe to retry later
+//	case c.Retryable:
+//	    // Check the transaction status, then retry if it was not created
+//	default:
+//	    // Page on-call
+//	}
+func Classify(err error) Classification {
+	if err == nil {
+		return Classification{}
+	}
+
+	// Cancellation wins: the caller gave up, whatever the attempt was doing.
+	if errors.Is(err, context.Canceled) {
+		return Classification{Category: CategoryCanceled, MayHaveReachedServer: true}
+	}
+	if errors.Is(err, context.DeadlineExceeded) {
+		return Classification{Category: CategoryCanceled, Retryable: true, MayHaveReachedServer: true}
+	}
+
+	if ce := (*ClassifiedError)(nil); errors.As(err, &ce) {
+		return ce.Class
+	}
+
+	if apiErr := GetAPIError(err); apiErr != nil {
+		if apiErr.Err != nil {
+			return ClassifyBusiness(apiErr.Code, apiErr.Err)
+		}
+		return ClassifyStatus(apiErr.Code)
+	}
+
+	// Includes the local pre-flight [ErrInsufficientBalance], which never
+	// reached GSPAY2.
+	if IsValidationError(err) {
+		return Classification{Category: CategoryValidation}
+	}
+	switch {
+	case errors.Is(err, ErrInvalidSignature), errors.Is(err, ErrIPNotWhitelisted),
+		errors.Is(err, ErrInvalidIPAddress), errors.Is(err, ErrNoMatchingMerchant):
+		return Classification{Category: CategoryAuth}
+	case errors.Is(err, ErrCredentialsUnavailable):
+		return Classification{Category: CategoryAuth, Retryable: true}
+	case errors.Is(err, ErrRateLimited):
+		return Classification{Category: CategoryRateLimited, Retryable: true, MayHaveReachedServer: true}
+	case errors.Is(err, ErrRequestFailed):
+		return ClassifyTransport(err)
+	case errors.Is(err, ErrEmptyResponse):
+		return Classification{Category: CategoryServer, Retryable: true, MayHaveReachedServer: true}
+	case errors.Is(err, ErrInvalidJSON), errors.Is(err, ErrResponseTooLarge):
+		return Classification{Category: CategoryServer, MayHaveReachedServer: true}
+	}
+
+	for _, sentinel := range []error{
+		ErrInvalidTransactionID, ErrInvalidAmount, ErrInvalidBankCode, ErrMissingCallbackField,
+		ErrEmptyQRContent, ErrQREncodeFailed, ErrRecordNotFound, ErrUnsupportedKind,
+		ErrUnknownMerchant, ErrDuplicateMerchant, ErrInvalidMerchantConfig, ErrInvalidConfig,
+	} {
+		if errors.Is(err, sentinel) {
+			return Classification{Category: CategoryValidation}
+		}
+	}
+
+	return Classification{Category: CategoryUnknown, MayHaveReachedServer: true}
+}
+
+// ClassifyStatus classifies a non-2xx HTTP status code, or an API error code
+// carried in a 2xx response body.
+func ClassifyStatus(code int) Classification {
+	reached := Classification{MayHaveReachedServer: true}
+	switch {
+	case code == http.StatusUnauthorized || code == http.StatusForbidden:
+		reached.Category = CategoryAuth
+	case code == http.StatusTooManyRequests:
+		reached.Category, reached.Retryable = CategoryRateLimited, true
+	case code >= 500 || code == http.StatusNotFound:
+		// 404 is transient on GSPAY2 during deployments; see the client retry logic.
+		reached.Category, reached.Retryable = CategoryServer, true
+	default:
+		reached.Category = CategoryRejected
+	}
+	return reached
+}
+
+// ClassifyTransport classifies an error from sending a request or reading its
+// response. Connection and DNS failures never reached the server.
+func ClassifyTransport(err error) Classification {
+	class := Classification{Category: CategoryNetwork, Retryable: true, MayHaveReachedServer: true}
+	var dnsErr *net.DNSError
+	var opErr *net.OpError
+	if errors.As(err, &dnsErr) || (errors.As(err, &opErr) && opErr.Op == "dial") {
+		class.MayHaveReachedServer = false
+	}
+	return class
+}
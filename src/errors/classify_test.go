@@ -0,0 +1,116 @@
+// Copyright 2026 H0llyW00dzZ
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package errors
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"testing"
+
+	"github.com/H0llyW00dzZ/gspay-go-sdk/src/i18n"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClassify(t *testing.T) {
+	dialErr := &net.OpError{Op: "dial", Net: "tcp", Err: errors.New("connection refused")}
+	readErr := &net.OpError{Op: "read", Net: "tcp", Err: errors.New("connection reset by peer")}
+
+	tests := []struct {
+		name string
+		err  error
+		want Classification
+	}{
+		{"nil", nil, Classification{}},
+		{"canceled", fmt.Errorf("wrapped: %w", context.Canceled),
+			Classification{Category: CategoryCanceled, MayHaveReachedServer: true}},
+		{"deadline", New(i18n.English, ErrRequestFailed, context.DeadlineExceeded),
+			Classification{Category: CategoryCanceled, Retryable: true, MayHaveReachedServer: true}},
+		{"explicit classification", WithClassification(New(i18n.English, ErrInvalidJSON), Classification{Category: CategoryValidation}),
+			Classification{Category: CategoryValidation}},
+		{"validation error", NewValidationError(i18n.English, "amount", "too small"),
+			Classification{Category: CategoryValidation}},
+		{"insufficient balance", &ValidationError{Field: "amount", Err: ErrInsufficientBalance},
+			Classification{Category: CategoryValidation}},
+		{"invalid signature", New(i18n.English, ErrInvalidSignature),
+			Classification{Category: CategoryAuth}},
+		{"ip not whitelisted", New(i18n.English, ErrIPNotWhitelisted, "1.2.3.4"),
+			Classification{Category: CategoryAuth}},
+		{"credentials unavailable", New(i18n.English, ErrCredentialsUnavailable),
+			Classification{Category: CategoryAuth, Retryable: true}},
+		{"rate limited", New(i18n.English, ErrRateLimited),
+			Classification{Category: CategoryRateLimited, Retryable: true, MayHaveReachedServer: true}},
+		{"dial failure", New(i18n.English, ErrRequestFailed, dialErr),
+			Classification{Category: CategoryNetwork, Retryable: true}},
+		{"dns failure", New(i18n.English, ErrRequestFailed, &net.DNSError{Err: "no such host", Name: "api.example.com"}),
+			Classification{Category: CategoryNetwork, Retryable: true}},
+		{"connection reset", New(i18n.English, ErrRequestFailed, readErr),
+			Classification{Category: CategoryNetwork, Retryable: true, MayHaveReachedServer: true}},
+		{"empty response", New(i18n.English, ErrEmptyResponse),
+			Classification{Category: CategoryServer, Retryable: true, MayHaveReachedServer: true}},
+		{"invalid json", New(i18n.English, ErrInvalidJSON),
+			Classification{Category: CategoryServer, MayHaveReachedServer: true}},
+		{"local sentinel", New(i18n.English, ErrInvalidTransactionID),
+			Classification{Category: CategoryValidation}},
+		{"api 500", &APIError{Code: 500},
+			Classification{Category: CategoryServer, Retryable: true, MayHaveReachedServer: true}},
+		{"api 401", &APIError{Code: 401},
+			Classification{Category: CategoryAuth, MayHaveReachedServer: true}},
+		{"api 400", fmt.Errorf("request failed after 3 retries: %w", &APIError{Code: 400}),
+			Classification{Category: CategoryRejected, MayHaveReachedServer: true}},
+		{"unknown", errors.New("boom"),
+			Classification{Category: CategoryUnknown, MayHaveReachedServer: true}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, Classify(tt.err))
+		})
+	}
+}
+
+func TestClassifyStatus(t *testing.T) {
+	tests := []struct {
+		code      int
+		category  Category
+		retryable bool
+	}{
+		{400, CategoryRejected, false},
+		{401, CategoryAuth, false},
+		{403, CategoryAuth, false},
+		{404, CategoryServer, true},
+		{422, CategoryRejected, false},
+		{429, CategoryRateLimited, true},
+		{500, CategoryServer, true},
+		{503, CategoryServer, true},
+	}
+	for _, tt := range tests {
+		t.Run(fmt.Sprint(tt.code), func(t *testing.T) {
+			got := ClassifyStatus(tt.code)
+			assert.Equal(t, tt.category, got.Category)
+			assert.Equal(t, tt.retryable, got.Retryable)
+			assert.True(t, got.MayHaveReachedServer)
+		})
+	}
+}
+
+func TestClassifiedError(t *testing.T) {
+	assert.NoError(t, WithClassification(nil, Classification{Category: CategoryServer}))
+
+	inner := New(i18n.English, ErrInvalidJSON)
+	err := WithClassification(inner, Classification{Category: CategoryServer})
+	assert.Equal(t, inner.Error(), err.Error())
+	assert.ErrorIs(t, err, ErrInvalidJSON)
+}
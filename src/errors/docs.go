@@ -22,7 +22,9 @@
 // The package provides several error types:
 //   - [APIError]: Errors returned from the GSPAY2 API
 //   - [ValidationError]: Client-side validation failures
+//   - [ValidationErrors]: Every failing field of a request
 //   - [LocalizedError]: Wrapper for errors with localized messages
+//   - [ClassifiedError]: Wrapper attaching a [Classification]
 //
 // # Sentinel Errors
 //
@@ -30,7 +32,9 @@
 //   - [ErrInvalidTransactionID]: Invalid or missing transaction ID
 //   - [ErrInvalidUsername]: Invalid or missing username
 //   - [ErrInvalidAmount]: Invalid payment amount
+//   - [ErrInvalidAccountName]: Missing bank account name
 //   - [ErrInvalidAccountNumber]: Invalid bank account number
+//   - [ErrInvalidDescription]: Description too long
 //   - [ErrInvalidBankCode]: Invalid or unsupported bank code
 //   - [ErrInvalidSignature]: Signature verification failed
 //   - [ErrRequestFailed]: HTTP request failed
@@ -54,6 +58,43 @@
 //	err := errors.New(i18n.Indonesian, errors.ErrInvalidAmount)
 //	// Error message will be in Indonesian
 //
+// # Classification
+//
+// [Classify] reports how to react to an error: its [Category], whether it is
+// retryable, and whether the request may have reached GSPAY2:
+//
+//	if c := errors.Classify(err); c.Retryable && !c.MayHaveReachedServer {
+//	    // Safe to retry later
+//	}
+//
+// The client attaches an explicit classification to every request failure
+// with [WithClassification]; other errors are classified by type and sentinel.
+//
+// # Business Errors
+//
+// GSPAY2 reports business failures as a non-200 code in the body of an HTTP
+// 200 reply. The client matches these against a catalog of [BusinessError]
+// entries, so [APIError] satisfies errors.Is for the matching sentinel:
+//
+//	if errors.Is(err, errors.ErrDuplicateTransaction) {
+//	    // Query the existing transaction instead
+//	}
+//	if apiErr := errors.GetAPIError(err); apiErr != nil {
+//	    fmt.Println(apiErr.Explanation()) // Localized next step
+//	}
+//
+// Entries for the exact code are checked first, and message phrases match
+// whole words only. To add codes or messages the catalog does not know,
+// register them on a [BusinessCatalog] passed to client.WithBusinessCatalog.
+//
+// # Problem Details
+//
+// [ToProblem] converts any SDK error to an RFC 7807 [Problem] with a stable
+// type URI, an English title, and a detail in the requested language.
+// [ProblemHandler] and [WriteProblem] write it as application/problem+json:
+//
+//	mux.Handle("POST /payouts", errors.ProblemHandler(i18n.Indonesian, createPayout))
+//
 // # Error Wrapping
 //
 // The [New] function supports error wrapping for proper error chains:
@@ -15,6 +15,7 @@
 package errors
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/H0llyW00dzZ/gspay-go-sdk/src/i18n"
@@ -76,6 +77,19 @@ func GetMessage(lang i18n.Language, key i18n.MessageKey) string {
 	return i18n.Get(lang, key)
 }
 
+// Is reports whether any error in err's tree matches target.
+// It delegates to the standard library [errors.Is] so callers do not need
+// to import both packages.
+func Is(err, target error) bool { return errors.Is(err, target) }
+
+// As finds the first error in err's tree that matches target.
+// It delegates to the standard library [errors.As].
+func As(err error, target any) bool { return errors.As(err, target) }
+
+// Join returns an error that wraps the given errors, discarding nils.
+// It delegates to the standard library [errors.Join].
+func Join(errs ...error) error { return errors.Join(errs...) }
+
 // Re-export i18n types and constants for convenience
 type (
 	// Language represents a supported language.
@@ -93,25 +107,56 @@ const (
 // Re-export message keys
 const (
 	// Sentinel error message keys
-	MsgInvalidTransactionID = i18n.MsgInvalidTransactionID
-	MsgInvalidAmount        = i18n.MsgInvalidAmount
-	MsgInvalidBankCode      = i18n.MsgInvalidBankCode
-	MsgInvalidSignature     = i18n.MsgInvalidSignature
-	MsgMissingCallbackField = i18n.MsgMissingCallbackField
-	MsgEmptyResponse        = i18n.MsgEmptyResponse
-	MsgInvalidJSON          = i18n.MsgInvalidJSON
-	MsgRequestFailed        = i18n.MsgRequestFailed
-	MsgIPNotWhitelisted     = i18n.MsgIPNotWhitelisted
-	MsgInvalidIPAddress     = i18n.MsgInvalidIPAddress
-	MsgRateLimited          = i18n.MsgRateLimited
-	MsgEmptyQRContent       = i18n.MsgEmptyQRContent
-	MsgQREncodeFailed       = i18n.MsgQREncodeFailed
+	MsgInvalidTransactionID   = i18n.MsgInvalidTransactionID
+	MsgInvalidAmount          = i18n.MsgInvalidAmount
+	MsgInvalidBankCode        = i18n.MsgInvalidBankCode
+	MsgInvalidSignature       = i18n.MsgInvalidSignature
+	MsgMissingCallbackField   = i18n.MsgMissingCallbackField
+	MsgEmptyResponse          = i18n.MsgEmptyResponse
+	MsgInvalidJSON            = i18n.MsgInvalidJSON
+	MsgRequestFailed          = i18n.MsgRequestFailed
+	MsgIPNotWhitelisted       = i18n.MsgIPNotWhitelisted
+	MsgInvalidIPAddress       = i18n.MsgInvalidIPAddress
+	MsgRateLimited            = i18n.MsgRateLimited
+	MsgEmptyQRContent         = i18n.MsgEmptyQRContent
+	MsgQREncodeFailed         = i18n.MsgQREncodeFailed
+	MsgRecordNotFound         = i18n.MsgRecordNotFound
+	MsgUnsupportedKind        = i18n.MsgUnsupportedKind
+	MsgInsufficientBalance    = i18n.MsgInsufficientBalance
+	MsgUnknownMerchant        = i18n.MsgUnknownMerchant
+	MsgDuplicateMerchant      = i18n.MsgDuplicateMerchant
+	MsgInvalidMerchantConfig  = i18n.MsgInvalidMerchantConfig
+	MsgNoMatchingMerchant     = i18n.MsgNoMatchingMerchant
+	MsgCredentialsUnavailable = i18n.MsgCredentialsUnavailable
+	MsgInvalidConfig          = i18n.MsgInvalidConfig
+	MsgResponseTooLarge       = i18n.MsgResponseTooLarge
+	MsgDuplicateTransaction   = i18n.MsgDuplicateTransaction
+	MsgBankMaintenance        = i18n.MsgBankMaintenance
+	MsgAccountMismatch        = i18n.MsgAccountMismatch
+	MsgInvalidUsername        = i18n.MsgInvalidUsername
+	MsgInvalidAccountName     = i18n.MsgInvalidAccountName
+	MsgInvalidAccountNumber   = i18n.MsgInvalidAccountNumber
+	MsgInvalidDescription     = i18n.MsgInvalidDescription
+	MsgInvalidChannel         = i18n.MsgInvalidChannel
+	MsgInvalidExpireDate      = i18n.MsgInvalidExpireDate
+	MsgIllegalTransition      = i18n.MsgIllegalTransition
+	MsgEventMismatch          = i18n.MsgEventMismatch
+	MsgUnknownCallback        = i18n.MsgUnknownCallback
+	MsgStaleTimestamp         = i18n.MsgStaleTimestamp
+	MsgCallbackTooLarge       = i18n.MsgCallbackTooLarge
+	MsgDuplicateKey           = i18n.MsgDuplicateKey
+	MsgDeliveryFailed         = i18n.MsgDeliveryFailed
 
 	// Validation error message keys
 	KeyMinAmountIDR        = i18n.MsgMinAmountIDR
 	KeyMinAmountUSDT       = i18n.MsgMinAmountUSDT
 	KeyMinPayoutAmountIDR  = i18n.MsgMinPayoutAmountIDR
 	KeyInvalidAmountFormat = i18n.MsgInvalidAmountFormat
+	KeyTransactionIDLength = i18n.MsgTransactionIDLength
+	KeyTransactionIDChars  = i18n.MsgTransactionIDChars
+	KeyDescriptionTooLong  = i18n.MsgDescriptionTooLong
+	KeyChannelAmountRange  = i18n.MsgChannelAmountRange
+	KeyChannelMinAmount    = i18n.MsgChannelMinAmount
 
 	// Request retry message keys
 	MsgRequestFailedAfterRetries = i18n.MsgRequestFailedAfterRetries
@@ -16,6 +16,7 @@ package errors
 
 import (
 	"errors"
+	"fmt"
 	"testing"
 
 	"github.com/H0llyW00dzZ/gspay-go-sdk/src/i18n"
@@ -226,6 +227,43 @@ func TestNewValidationError(t *testing.T) {
 	assert.Equal(t, i18n.English, err.Lang)
 }
 
+func TestValidationError_Unwrap(t *testing.T) {
+	err := NewValidationError(i18n.English, "amount", "insufficient balance")
+	assert.NoError(t, err.Unwrap())
+	assert.False(t, Is(err, ErrInsufficientBalance))
+
+	err.Err = ErrInsufficientBalance
+	wrapped := fmt.Errorf("create payout: %w", err)
+	assert.True(t, Is(wrapped, ErrInsufficientBalance))
+	assert.True(t, IsValidationError(wrapped))
+}
+
+func TestValidationErrors(t *testing.T) {
+	var errs ValidationErrors
+	assert.NoError(t, errs.Err())
+	assert.Nil(t, GetValidationErrors(errs.Err()))
+
+	errs.Add(i18n.English, "username", "username is required", ErrInvalidUsername)
+	errs.Add(i18n.English, "amount", "too small", ErrInvalidAmount)
+
+	err := fmt.Errorf("create: %w", errs.Err())
+	assert.Equal(t, "create: gspay: validation error for username: username is required; gspay: validation error for amount: too small", err.Error())
+	assert.True(t, errors.Is(err, ErrInvalidUsername))
+	assert.True(t, errors.Is(err, ErrInvalidAmount))
+	assert.True(t, IsValidationError(err))
+	assert.Equal(t, "username", GetValidationError(err).Field)
+	assert.Len(t, GetValidationErrors(err), 2)
+	assert.Equal(t, "too small", errs.Field("amount").Message)
+	assert.Nil(t, errs.Field("bank_code"))
+
+	t.Run("single validation error", func(t *testing.T) {
+		got := GetValidationErrors(NewValidationError(i18n.English, "amount", "too small"))
+		if assert.Len(t, got, 1) {
+			assert.Equal(t, "amount", got[0].Field)
+		}
+	})
+}
+
 func TestIsValidationError(t *testing.T) {
 	t.Run("returns true for ValidationError", func(t *testing.T) {
 		err := &ValidationError{Field: "test", Message: "error"}
@@ -380,3 +418,14 @@ func TestLocalizedErrorMessageKeys(t *testing.T) {
 		})
 	}
 }
+
+func TestIsAs(t *testing.T) {
+	err := New(i18n.English, ErrRequestFailed, &APIError{Code: 500})
+
+	assert.True(t, Is(err, ErrRequestFailed))
+	assert.False(t, Is(err, ErrInvalidAmount))
+
+	var apiErr *APIError
+	assert.True(t, As(err, &apiErr))
+	assert.Equal(t, 500, apiErr.Code)
+}
@@ -40,11 +40,17 @@ import (
 type LocalizedError struct {
 	key  i18n.MessageKey
 	lang i18n.Language
+	args []any
 }
 
 // Error implements the error interface.
 func (e *LocalizedError) Error() string {
-	return i18n.Get(e.lang, e.key)
+	return e.Localize(e.lang)
+}
+
+// Localize returns the message in lang, formatted with the error's arguments.
+func (e *LocalizedError) Localize(lang i18n.Language) string {
+	return format(lang, e.key, e.args)
 }
 
 // Key returns the message key of the error.
@@ -52,9 +58,10 @@ func (e *LocalizedError) Key() i18n.MessageKey {
 	return e.key
 }
 
-// NewLocalizedError creates a new localized error with the specified language and message key.
-func NewLocalizedError(lang i18n.Language, key i18n.MessageKey) *LocalizedError {
-	return &LocalizedError{key: key, lang: lang}
+// NewLocalizedError creates a new localized error with the specified language
+// and message key. Optional args format the message, as with fmt.Sprintf.
+func NewLocalizedError(lang i18n.Language, key i18n.MessageKey, args ...any) *LocalizedError {
+	return &LocalizedError{key: key, lang: lang, args: args}
 }
 
 // IsLocalizedError checks if an error is a LocalizedError.
@@ -0,0 +1,231 @@
+// Copyright 2026 H0llyW00dzZ
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package errors
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/H0llyW00dzZ/gspay-go-sdk/src/i18n"
+	"github.com/H0llyW00dzZ/gspay-go-sdk/src/internal/sanitize"
+)
+
+const (
+	// ProblemContentType is the media type of a serialized [Problem].
+	ProblemContentType = "application/problem+json"
+	// ProblemTypePrefix prefixes the message key of a known error to form
+	// the problem type URI, e.g. "urn:gspay:error:invalid_amount".
+	ProblemTypePrefix = "urn:gspay:error:"
+)
+
+// Problem is an RFC 7807 problem details object describing an SDK error.
+//
+// The raw GSPAY2 response body is deliberately never included, since it may
+// carry account data that must not reach frontends.
+type Problem struct {
+	// Type identifies the kind of problem. It is "about:blank" for errors the
+	// SDK does not know.
+	Type string `json:"type"`
+	// Title is a short English summary of the problem type. It does not vary
+	// between occurrences, so it is safe to group on.
+	Title string `json:"title"`
+	// Status is the HTTP status code suggested for relaying the error.
+	Status int `json:"status"`
+	// Detail is a localized explanation of this occurrence.
+	Detail string `json:"detail,omitempty"`
+	// Instance identifies this occurrence, e.g. the request path.
+	Instance string `json:"instance,omitempty"`
+	// Field is the invalid request field of a [ValidationError].
+	Field string `json:"field,omitempty"`
+	// InvalidParams lists every failing field of a [ValidationErrors].
+	InvalidParams []InvalidParam `json:"invalid-params,omitempty"`
+	// Code is the GSPAY2 code of an [APIError].
+	Code int `json:"code,omitempty"`
+	// Endpoint is the sanitized GSPAY2 endpoint of an [APIError].
+	Endpoint string `json:"endpoint,omitempty"`
+	// Category is the [Classification] category of the error.
+	Category Category `json:"category"`
+	// Retryable reports whether the same request may succeed later.
+	Retryable bool `json:"retryable"`
+}
+
+// InvalidParam describes one failing request field of a [Problem].
+type InvalidParam struct {
+	Name   string `json:"name"`
+	Reason string `json:"reason"`
+}
+
+// ToProblem converts err to a [Problem] with its detail in lang. It returns
+// nil if err is nil.
+//
+// Validation failures and [LocalizedError] values are rendered in lang from
+// their message key and arguments; a [ValidationError] built from a plain
+// message with [NewValidationError] keeps that message. An [APIError] in the
+// business error catalog uses its explanation; other API errors keep the
+// GSPAY2 message.
+//
+// The status is derived from [Classify]: validation errors map to 400,
+// rejections to 422, rate limiting to 429, cancellation to 504, network,
+// server, and upstream auth failures to 502, and unknown errors to 500.
+func ToProblem(err error, lang i18n.Language) *Problem {
+	if err == nil {
+		return nil
+	}
+
+	class := Classify(err)
+	p := &Problem{
+		Type:      "about:blank",
+		Status:    problemStatus(class.Category),
+		Category:  class.Category,
+		Retryable: class.Retryable,
+	}
+
+	var (
+		valErr *ValidationError
+		apiErr *APIError
+		locErr *LocalizedError
+	)
+	switch {
+	case errors.As(err, &valErr):
+		p.Type = ProblemTypePrefix + "validation"
+		p.Title = i18n.Get(i18n.English, i18n.MsgProblemValidationTitle)
+		p.Detail = valErr.Localize(lang)
+		p.Field = valErr.Field
+		if key, ok := sentinelKey(valErr.Err); ok {
+			p.Type = ProblemTypePrefix + string(key)
+			p.Title = i18n.Get(i18n.English, key)
+		}
+		if p.Detail == "" {
+			p.Detail = i18n.Get(lang, i18n.MsgProblemValidationTitle)
+		}
+		if all := GetValidationErrors(err); len(all) > 1 {
+			// Several fields failed: describe the request, list the fields.
+			p.Type = ProblemTypePrefix + "validation"
+			p.Title = i18n.Get(i18n.English, i18n.MsgProblemValidationTitle)
+			p.Detail = i18n.Get(lang, i18n.MsgProblemValidationTitle)
+			p.Field = ""
+			for _, e := range all {
+				p.InvalidParams = append(p.InvalidParams, InvalidParam{Name: e.Field, Reason: e.Localize(lang)})
+			}
+		}
+	case errors.As(err, &apiErr):
+		p.Type = ProblemTypePrefix + "api_error"
+		p.Title = i18n.Get(i18n.English, i18n.MsgProblemAPITitle)
+		p.Detail = apiErr.Message
+		p.Code = apiErr.Code
+		p.Endpoint = sanitize.Endpoint(apiErr.Endpoint)
+		if key, ok := sentinelKey(apiErr.Err); ok {
+			p.Type = ProblemTypePrefix + string(key)
+			p.Title = i18n.Get(i18n.English, key)
+		}
+		if apiErr.explanation != "" {
+			p.Detail = i18n.Get(lang, apiErr.explanation)
+		}
+	case errors.As(err, &locErr):
+		p.Type = ProblemTypePrefix + string(locErr.key)
+		p.Title = i18n.Get(i18n.English, locErr.key)
+		p.Detail = locErr.Localize(lang)
+	default:
+		if key, ok := sentinelKey(err); ok {
+			p.Type = ProblemTypePrefix + string(key)
+			p.Title = i18n.Get(i18n.English, key)
+			p.Detail = i18n.Get(lang, key)
+		}
+	}
+
+	if p.Title == "" {
+		p.Title = http.StatusText(p.Status)
+	}
+	return p
+}
+
+// WriteProblem writes err to w as an application/problem+json response with
+// its detail in lang. instance is optional and usually the request path.
+func WriteProblem(w http.ResponseWriter, err error, lang i18n.Language, instance string) {
+	p := ToProblem(err, lang)
+	if p == nil {
+		return
+	}
+	p.Instance = instance
+	w.Header().Set("Content-Type", ProblemContentType)
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	w.WriteHeader(p.Status)
+	_ = json.NewEncoder(w).Encode(p)
+}
+
+// ProblemHandler adapts a handler that returns an error into an
+// [http.Handler]. A non-nil error is written with [WriteProblem], using the
+// request path as the problem instance.
+//
+// Example:
+//
+//	mux.Handle("POST /payouts", errors.ProblemHandler(i18n.Indonesian,
+//	    func(w http.ResponseWriter, r *http.Request) error {
+//	        resp, err := payoutSvc.Create(r.Context(), req)
+//	        if err != nil {
+//	            return err
+//	        }
+//	        return json.NewEncoder(w).Encode(resp)
+//	    }))
+func ProblemHandler(lang i18n.Language, fn func(http.ResponseWriter, *http.Request) error) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := fn(w, r); err != nil {
+			WriteProblem(w, err, lang, r.URL.Path)
+		}
+	})
+}
+
+// problemStatus maps a classification category to an HTTP status.
+func problemStatus(category Category) int {
+	switch category {
+	case CategoryValidation:
+		return http.StatusBadRequest
+	case CategoryRejected:
+		return http.StatusUnprocessableEntity
+	case CategoryRateLimited:
+		return http.StatusTooManyRequests
+	case CategoryCanceled:
+		return http.StatusGatewayTimeout
+	case CategoryNetwork, CategoryServer, CategoryAuth:
+		// The failure happened between us and GSPAY2, not in the caller's request.
+		return http.StatusBadGateway
+	}
+	return http.StatusInternalServerError
+}
+
+// sentinelKey returns the message key of the outermost sentinel in err's tree.
+func sentinelKey(err error) (i18n.MessageKey, bool) {
+	if err == nil {
+		return "", false
+	}
+	// Compare rather than index: err may have a non-comparable dynamic type.
+	for sentinel, key := range sentinelMessages {
+		if err == sentinel {
+			return key, true
+		}
+	}
+	switch u := err.(type) {
+	case interface{ Unwrap() error }:
+		return sentinelKey(u.Unwrap())
+	case interface{ Unwrap() []error }:
+		for _, e := range u.Unwrap() {
+			if key, ok := sentinelKey(e); ok {
+				return key, true
+			}
+		}
+	}
+	return "", false
+}
@@ -0,0 +1,178 @@
+// Copyright 2026 H0llyW00dzZ
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package errors
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/H0llyW00dzZ/gspay-go-sdk/src/i18n"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestToProblem(t *testing.T) {
+	t.Run("nil", func(t *testing.T) {
+		assert.Nil(t, ToProblem(nil, i18n.English))
+	})
+
+	t.Run("validation error", func(t *testing.T) {
+		err := &ValidationError{Field: "amount", Message: "jumlah minimal 10000", Lang: i18n.Indonesian, Err: ErrInvalidAmount}
+		p := ToProblem(fmt.Errorf("create: %w", err), i18n.Indonesian)
+		assert.Equal(t, "urn:gspay:error:invalid_amount", p.Type)
+		assert.Equal(t, "invalid payment amount", p.Title)
+		assert.Equal(t, "jumlah minimal 10000", p.Detail)
+		assert.Equal(t, "amount", p.Field)
+		assert.Equal(t, http.StatusBadRequest, p.Status)
+		assert.Equal(t, CategoryValidation, p.Category)
+	})
+
+	t.Run("validation error without sentinel", func(t *testing.T) {
+		p := ToProblem(NewValidationError(i18n.English, "username", ""), i18n.Indonesian)
+		assert.Equal(t, "urn:gspay:error:validation", p.Type)
+		assert.Equal(t, "request validation failed", p.Title)
+		assert.Equal(t, "validasi permintaan gagal", p.Detail)
+	})
+
+	t.Run("several validation errors", func(t *testing.T) {
+		var errs ValidationErrors
+		errs.Add(i18n.Indonesian, "username", "nama pengguna wajib diisi", ErrInvalidUsername)
+		errs.Add(i18n.Indonesian, "amount", "jumlah minimal 10000", ErrInvalidAmount)
+		p := ToProblem(errs, i18n.Indonesian)
+		assert.Equal(t, "urn:gspay:error:validation", p.Type)
+		assert.Empty(t, p.Field)
+		assert.Equal(t, http.StatusBadRequest, p.Status)
+		assert.Equal(t, []InvalidParam{
+			{Name: "username", Reason: "nama pengguna wajib diisi"},
+			{Name: "amount", Reason: "jumlah minimal 10000"},
+		}, p.InvalidParams)
+	})
+
+	t.Run("validation errors render in the requested language", func(t *testing.T) {
+		var errs ValidationErrors
+		errs.AddKey(i18n.English, "username", ErrInvalidUsername, i18n.MsgInvalidUsername)
+		errs.AddKey(i18n.English, "amount", ErrInvalidAmount, i18n.MsgMinAmountIDR)
+		assert.Contains(t, errs.Error(), "username is required")
+		p := ToProblem(errs, i18n.Indonesian)
+		assert.Equal(t, []InvalidParam{
+			{Name: "username", Reason: "nama pengguna wajib diisi"},
+			{Name: "amount", Reason: "jumlah minimum adalah 10000 IDR"},
+		}, p.InvalidParams)
+
+		single := NewValidationErrorKey(i18n.English, "bank_code", i18n.MsgValidationSubject, "XYZ", i18n.MsgInvalidBankCode)
+		assert.Equal(t, "XYZ: "+i18n.Get(i18n.Indonesian, i18n.MsgInvalidBankCode), ToProblem(single, i18n.Indonesian).Detail)
+	})
+
+	t.Run("local insufficient balance is a validation error", func(t *testing.T) {
+		p := ToProblem(&ValidationError{Field: "amount", Err: ErrInsufficientBalance}, i18n.English)
+		assert.Equal(t, http.StatusBadRequest, p.Status)
+		assert.False(t, p.Retryable)
+	})
+
+	t.Run("cataloged api error", func(t *testing.T) {
+		apiErr := NewBusinessError(i18n.English, 400, "Duplicate transaction ID", "/v2/integrations/operators/secret-auth-key/idr/payment", `{"account":"123"}`)
+		p := ToProblem(WithClassification(apiErr, ClassifyBusiness(apiErr.Code, apiErr.Err)), i18n.Indonesian)
+		assert.Equal(t, "urn:gspay:error:duplicate_transaction", p.Type)
+		assert.Equal(t, "duplicate transaction ID", p.Title)
+		assert.Equal(t, i18n.Get(i18n.Indonesian, i18n.MsgExplainDuplicateTransaction), p.Detail)
+		assert.Equal(t, 400, p.Code)
+		assert.NotContains(t, p.Endpoint, "secret-auth-key")
+		assert.Equal(t, http.StatusUnprocessableEntity, p.Status)
+	})
+
+	t.Run("uncataloged api error", func(t *testing.T) {
+		p := ToProblem(&APIError{Code: 503, Message: "HTTP Error: 503"}, i18n.English)
+		assert.Equal(t, "urn:gspay:error:api_error", p.Type)
+		assert.Equal(t, "GSPAY2 API error", p.Title)
+		assert.Equal(t, "HTTP Error: 503", p.Detail)
+		assert.Equal(t, http.StatusBadGateway, p.Status)
+		assert.True(t, p.Retryable)
+	})
+
+	t.Run("localized error", func(t *testing.T) {
+		p := ToProblem(NewLocalizedError(i18n.English, i18n.MsgInvalidBankCode), i18n.Indonesian)
+		assert.Equal(t, "urn:gspay:error:invalid_bank_code", p.Type)
+		assert.Equal(t, i18n.Get(i18n.Indonesian, i18n.MsgInvalidBankCode), p.Detail)
+	})
+
+	t.Run("localized error with arguments", func(t *testing.T) {
+		err := NewLocalizedError(i18n.English, i18n.MsgAmountExceedsBalance, int64(50000), 100.5)
+		assert.Equal(t, "insufficient balance: 50000 > 100.50", err.Error())
+		assert.Equal(t, "saldo tidak mencukupi: 50000 > 100.50", ToProblem(err, i18n.Indonesian).Detail)
+	})
+
+	t.Run("sentinel error", func(t *testing.T) {
+		err := New(i18n.English, ErrRequestFailed, "/v2/balance", errors.New("dial tcp: connection refused"))
+		p := ToProblem(err, i18n.Indonesian)
+		assert.Equal(t, "urn:gspay:error:request_failed", p.Type)
+		assert.Equal(t, i18n.Get(i18n.English, i18n.MsgRequestFailed), p.Title)
+		assert.Equal(t, i18n.Get(i18n.Indonesian, i18n.MsgRequestFailed), p.Detail)
+		assert.NotContains(t, p.Detail, "dial tcp")
+	})
+
+	t.Run("unknown error", func(t *testing.T) {
+		p := ToProblem(errors.New("boom"), i18n.English)
+		assert.Equal(t, "about:blank", p.Type)
+		assert.Equal(t, "Internal Server Error", p.Title)
+		assert.Empty(t, p.Detail)
+		assert.Equal(t, http.StatusInternalServerError, p.Status)
+	})
+
+	t.Run("json shape", func(t *testing.T) {
+		data, err := json.Marshal(ToProblem(New(i18n.English, ErrInvalidAmount), i18n.English))
+		require.NoError(t, err)
+		assert.JSONEq(t, `{
+			"type": "urn:gspay:error:invalid_amount",
+			"title": "invalid payment amount",
+			"status": 400,
+			"detail": "invalid payment amount",
+			"category": "validation",
+			"retryable": false
+		}`, string(data))
+	})
+}
+
+func TestProblemHandler(t *testing.T) {
+	t.Run("writes problem on error", func(t *testing.T) {
+		h := ProblemHandler(i18n.English, func(w http.ResponseWriter, r *http.Request) error {
+			return New(i18n.English, ErrInvalidTransactionID)
+		})
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/payments", nil))
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		assert.Equal(t, ProblemContentType, rec.Header().Get("Content-Type"))
+
+		var p Problem
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &p))
+		assert.Equal(t, "urn:gspay:error:invalid_transaction_id", p.Type)
+		assert.Equal(t, "/payments", p.Instance)
+	})
+
+	t.Run("leaves successful responses alone", func(t *testing.T) {
+		h := ProblemHandler(i18n.English, func(w http.ResponseWriter, r *http.Request) error {
+			w.WriteHeader(http.StatusCreated)
+			return nil
+		})
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/payments", nil))
+		assert.Equal(t, http.StatusCreated, rec.Code)
+		assert.Empty(t, rec.Body.String())
+	})
+}
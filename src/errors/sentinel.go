@@ -49,21 +49,99 @@ var (
 	ErrEmptyQRContent = errors.New("ErrEmptyQRContent")
 	// ErrQREncodeFailed is returned when QR code encoding fails (e.g., content too long).
 	ErrQREncodeFailed = errors.New("ErrQREncodeFailed")
+	// ErrRecordNotFound is returned when a ledger record does not exist.
+	ErrRecordNotFound = errors.New("ErrRecordNotFound")
+	// ErrUnsupportedKind is returned when an operation is not available for a transaction kind.
+	ErrUnsupportedKind = errors.New("ErrUnsupportedKind")
+	// ErrInsufficientBalance is returned when the settlement balance cannot cover a payout.
+	ErrInsufficientBalance = errors.New("ErrInsufficientBalance")
+	// ErrUnknownMerchant is returned when a merchant ID is not registered.
+	ErrUnknownMerchant = errors.New("ErrUnknownMerchant")
+	// ErrDuplicateMerchant is returned when registering a merchant ID twice.
+	ErrDuplicateMerchant = errors.New("ErrDuplicateMerchant")
+	// ErrInvalidMerchantConfig is returned when merchant credentials are incomplete.
+	ErrInvalidMerchantConfig = errors.New("ErrInvalidMerchantConfig")
+	// ErrNoMatchingMerchant is returned when a callback cannot be verified with any registered merchant.
+	ErrNoMatchingMerchant = errors.New("ErrNoMatchingMerchant")
+	// ErrCredentialsUnavailable is returned when a Credentials provider fails to supply keys.
+	ErrCredentialsUnavailable = errors.New("ErrCredentialsUnavailable")
+	// ErrInvalidConfig is returned when a client Config fails validation.
+	ErrInvalidConfig = errors.New("ErrInvalidConfig")
+	// ErrResponseTooLarge is returned when a response body exceeds the configured maximum size.
+	ErrResponseTooLarge = errors.New("ErrResponseTooLarge")
+	// ErrDuplicateTransaction is returned when GSPAY2 rejects an already used transaction ID.
+	ErrDuplicateTransaction = errors.New("ErrDuplicateTransaction")
+	// ErrBankMaintenance is returned when the target bank or channel is under maintenance.
+	ErrBankMaintenance = errors.New("ErrBankMaintenance")
+	// ErrAccountMismatch is returned when the payout account name and number do not match.
+	ErrAccountMismatch = errors.New("ErrAccountMismatch")
+	// ErrInvalidUsername is returned when the username is missing.
+	ErrInvalidUsername = errors.New("ErrInvalidUsername")
+	// ErrInvalidAccountName is returned when the payout account name is missing.
+	ErrInvalidAccountName = errors.New("ErrInvalidAccountName")
+	// ErrInvalidAccountNumber is returned when the payout account number is missing or not numeric.
+	ErrInvalidAccountNumber = errors.New("ErrInvalidAccountNumber")
+	// ErrInvalidDescription is returned when the transaction description is too long.
+	ErrInvalidDescription = errors.New("ErrInvalidDescription")
+	// ErrInvalidChannel is returned when a payment channel is not registered.
+	ErrInvalidChannel = errors.New("ErrInvalidChannel")
+	// ErrInvalidExpireDate is returned when an expire date cannot be parsed.
+	ErrInvalidExpireDate = errors.New("ErrInvalidExpireDate")
+	// ErrIllegalTransition is returned when a lifecycle event would move a transaction to a state it cannot reach.
+	ErrIllegalTransition = errors.New("ErrIllegalTransition")
+	// ErrEventMismatch is returned when a lifecycle event does not match the transaction it is applied to.
+	ErrEventMismatch = errors.New("ErrEventMismatch")
+	// ErrUnknownCallback is returned when a webhook payload does not match any registered callback type.
+	ErrUnknownCallback = errors.New("ErrUnknownCallback")
+	// ErrStaleTimestamp is returned when a signed webhook timestamp is too old or too far in the future.
+	ErrStaleTimestamp = errors.New("ErrStaleTimestamp")
+	// ErrCallbackTooLarge is returned when a callback body exceeds the configured maximum size.
+	ErrCallbackTooLarge = errors.New("ErrCallbackTooLarge")
+	// ErrDuplicateKey is returned when a strictly decoded callback repeats a JSON key.
+	ErrDuplicateKey = errors.New("ErrDuplicateKey")
+	// ErrDeliveryFailed is returned when a forwarding target does not answer with a 2xx status.
+	ErrDeliveryFailed = errors.New("ErrDeliveryFailed")
 )
 
 // sentinelMessages maps sentinel errors to their message keys.
 var sentinelMessages = map[error]i18n.MessageKey{
-	ErrInvalidTransactionID: MsgInvalidTransactionID,
-	ErrInvalidAmount:        MsgInvalidAmount,
-	ErrInvalidBankCode:      MsgInvalidBankCode,
-	ErrInvalidSignature:     MsgInvalidSignature,
-	ErrMissingCallbackField: MsgMissingCallbackField,
-	ErrEmptyResponse:        MsgEmptyResponse,
-	ErrInvalidJSON:          MsgInvalidJSON,
-	ErrRequestFailed:        MsgRequestFailed,
-	ErrIPNotWhitelisted:     MsgIPNotWhitelisted,
-	ErrInvalidIPAddress:     MsgInvalidIPAddress,
-	ErrRateLimited:          MsgRateLimited,
-	ErrEmptyQRContent:       MsgEmptyQRContent,
-	ErrQREncodeFailed:       MsgQREncodeFailed,
+	ErrInvalidTransactionID:   MsgInvalidTransactionID,
+	ErrInvalidAmount:          MsgInvalidAmount,
+	ErrInvalidBankCode:        MsgInvalidBankCode,
+	ErrInvalidSignature:       MsgInvalidSignature,
+	ErrMissingCallbackField:   MsgMissingCallbackField,
+	ErrEmptyResponse:          MsgEmptyResponse,
+	ErrInvalidJSON:            MsgInvalidJSON,
+	ErrRequestFailed:          MsgRequestFailed,
+	ErrIPNotWhitelisted:       MsgIPNotWhitelisted,
+	ErrInvalidIPAddress:       MsgInvalidIPAddress,
+	ErrRateLimited:            MsgRateLimited,
+	ErrEmptyQRContent:         MsgEmptyQRContent,
+	ErrQREncodeFailed:         MsgQREncodeFailed,
+	ErrRecordNotFound:         MsgRecordNotFound,
+	ErrUnsupportedKind:        MsgUnsupportedKind,
+	ErrInsufficientBalance:    MsgInsufficientBalance,
+	ErrUnknownMerchant:        MsgUnknownMerchant,
+	ErrDuplicateMerchant:      MsgDuplicateMerchant,
+	ErrInvalidMerchantConfig:  MsgInvalidMerchantConfig,
+	ErrNoMatchingMerchant:     MsgNoMatchingMerchant,
+	ErrCredentialsUnavailable: MsgCredentialsUnavailable,
+	ErrInvalidConfig:          MsgInvalidConfig,
+	ErrResponseTooLarge:       MsgResponseTooLarge,
+	ErrDuplicateTransaction:   MsgDuplicateTransaction,
+	ErrBankMaintenance:        MsgBankMaintenance,
+	ErrAccountMismatch:        MsgAccountMismatch,
+	ErrInvalidUsername:        MsgInvalidUsername,
+	ErrInvalidAccountName:     MsgInvalidAccountName,
+	ErrInvalidAccountNumber:   MsgInvalidAccountNumber,
+	ErrInvalidDescription:     MsgInvalidDescription,
+	ErrInvalidChannel:         MsgInvalidChannel,
+	ErrInvalidExpireDate:      MsgInvalidExpireDate,
+	ErrIllegalTransition:      MsgIllegalTransition,
+	ErrEventMismatch:          MsgEventMismatch,
+	ErrUnknownCallback:        MsgUnknownCallback,
+	ErrStaleTimestamp:         MsgStaleTimestamp,
+	ErrCallbackTooLarge:       MsgCallbackTooLarge,
+	ErrDuplicateKey:           MsgDuplicateKey,
+	ErrDeliveryFailed:         MsgDeliveryFailed,
 }
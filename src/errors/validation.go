@@ -17,6 +17,7 @@ package errors
 import (
 	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/H0llyW00dzZ/gspay-go-sdk/src/i18n"
 )
@@ -26,6 +27,11 @@ type ValidationError struct {
 	Field   string
 	Message string
 	Lang    i18n.Language
+	// Err is the underlying sentinel error, if any (e.g., [ErrInsufficientBalance]).
+	Err error
+	// key and args build Message, so it can be rendered in another language.
+	key  i18n.MessageKey
+	args []any
 }
 
 // Error implements the error interface.
@@ -34,11 +40,118 @@ func (e *ValidationError) Error() string {
 	return fmt.Sprintf(format, e.Field, e.Message)
 }
 
+// Unwrap returns the underlying sentinel error, if any.
+func (e *ValidationError) Unwrap() error { return e.Err }
+
 // NewValidationError creates a new ValidationError.
 func NewValidationError(lang i18n.Language, field, message string) *ValidationError {
 	return &ValidationError{Field: field, Message: message, Lang: lang}
 }
 
+// NewValidationErrorKey creates a ValidationError whose message is the
+// message of key in lang, formatted with args. Arguments that are themselves
+// an [i18n.MessageKey] are localized too. Unlike [NewValidationError], the
+// message can be rendered in another language with [ValidationError.Localize].
+func NewValidationErrorKey(lang i18n.Language, field string, key i18n.MessageKey, args ...any) *ValidationError {
+	return &ValidationError{Field: field, Message: format(lang, key, args), Lang: lang, key: key, args: args}
+}
+
+// Localize returns the message in lang. A message built without a key, as by
+// [NewValidationError], is returned unchanged.
+func (e *ValidationError) Localize(lang i18n.Language) string {
+	if e.key == "" {
+		return e.Message
+	}
+	return format(lang, e.key, e.args)
+}
+
+// format returns the message of key in lang, formatted with args. Arguments
+// of type [i18n.MessageKey] are replaced by their message in lang.
+func format(lang i18n.Language, key i18n.MessageKey, args []any) string {
+	msg := i18n.Get(lang, key)
+	if len(args) == 0 {
+		return msg
+	}
+	localized := make([]any, len(args))
+	for i, arg := range args {
+		if k, ok := arg.(i18n.MessageKey); ok {
+			arg = i18n.Get(lang, k)
+		}
+		localized[i] = arg
+	}
+	return fmt.Sprintf(msg, localized...)
+}
+
+// ValidationErrors collects every field failure of a request, so callers can
+// report them all at once instead of one at a time.
+//
+// errors.As(err, &valErr) on a ValidationErrors finds the first failure, and
+// errors.Is matches the sentinel of any failure.
+type ValidationErrors []*ValidationError
+
+// Error implements the error interface, joining the failures with "; ".
+func (v ValidationErrors) Error() string {
+	msgs := make([]string, len(v))
+	for i, e := range v {
+		msgs[i] = e.Error()
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// Unwrap returns the individual failures.
+func (v ValidationErrors) Unwrap() []error {
+	errs := make([]error, len(v))
+	for i, e := range v {
+		errs[i] = e
+	}
+	return errs
+}
+
+// Add appends a failure for field with the given message and sentinel.
+func (v *ValidationErrors) Add(lang i18n.Language, field, message string, sentinel error) {
+	*v = append(*v, &ValidationError{Field: field, Message: message, Lang: lang, Err: sentinel})
+}
+
+// AddKey appends a failure for field with the given sentinel, whose message
+// is built from key and args as by [NewValidationErrorKey].
+func (v *ValidationErrors) AddKey(lang i18n.Language, field string, sentinel error, key i18n.MessageKey, args ...any) {
+	e := NewValidationErrorKey(lang, field, key, args...)
+	e.Err = sentinel
+	*v = append(*v, e)
+}
+
+// Field returns the first failure for field, or nil if the field is valid.
+func (v ValidationErrors) Field(field string) *ValidationError {
+	for _, e := range v {
+		if e.Field == field {
+			return e
+		}
+	}
+	return nil
+}
+
+// Err returns v as an error, or nil if there are no failures.
+func (v ValidationErrors) Err() error {
+	if len(v) == 0 {
+		return nil
+	}
+	return v
+}
+
+// GetValidationErrors extracts every [ValidationError] from err: all failures
+// of a [ValidationErrors], or a single-element slice for a lone
+// [ValidationError]. Returns nil if err contains no validation failure.
+func GetValidationErrors(err error) ValidationErrors {
+	var errs ValidationErrors
+	if errors.As(err, &errs) {
+		return errs
+	}
+	if valErr := GetValidationError(err); valErr != nil {
+		return ValidationErrors{valErr}
+	}
+	return nil
+}
+
 // IsValidationError checks if an error is a ValidationError.
 func IsValidationError(err error) bool {
 	var valErr *ValidationError
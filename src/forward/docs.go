@@ -0,0 +1,55 @@
+// Copyright 2026 H0llyW00dzZ
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package forward re-publishes verified GSPAY2 events to internal services.
+//
+// Internal services should not need to know GSPAY2 MD5 signatures or its IP
+// whitelist. A [Forwarder] subscribes to the webhook router and posts every
+// normalized [lifecycle.Event] as JSON to the configured targets, signed with
+// a per-target HMAC-SHA256 secret:
+//
+//	X-GSPay-Signature: sha256=<hex HMAC-SHA256 of "<timestamp>.<body>">
+//	X-GSPay-Timestamp: <unix seconds>
+//	X-GSPay-Event-ID:  <stable event ID>
+//
+// # Basic Usage
+//
+//	fwd := forward.New(
+//	    forward.WithTarget("https://orders.internal/gspay-events", os.Getenv("ORDERS_SECRET")),
+//	    forward.WithDeliveryLog(forward.NewMemoryLog(0)),
+//	)
+//	rt := webhook.NewRouter(
+//	    webhook.WithRoute(webhook.PaymentIDR(paymentSvc, nil)),
+//	    webhook.WithQueue(q),
+//	    webhook.WithSubscriber(fwd.Publish),
+//	)
+//
+// Always subscribe a Forwarder behind webhook.WithQueue. Publish waits for
+// every target, so without a queue it holds the GSPAY2 callback open, and a
+// single failing target makes the router answer 500 and GSPAY2 redeliver.
+//
+// # Retries
+//
+// Network errors and 408, 429 and 5xx responses are retried with exponential
+// backoff up to [WithAttempts] times per target; targets are delivered to
+// concurrently. A [Forwarder.Publish] error makes the queue retry the whole
+// job, so receivers must deduplicate on [HeaderEventID]. Rejected deliveries
+// wrap errors.ErrDeliveryFailed.
+//
+// # Receiving
+//
+// Receivers verify requests with [Verify], or [VerifySignature] when they
+// read the body themselves. Requests older than [DefaultTolerance] are
+// rejected to limit replays.
+package forward
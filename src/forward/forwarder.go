@@ -0,0 +1,268 @@
+// Copyright 2026 H0llyW00dzZ
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package forward
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/H0llyW00dzZ/gspay-go-sdk/src/errors"
+	"github.com/H0llyW00dzZ/gspay-go-sdk/src/i18n"
+	"github.com/H0llyW00dzZ/gspay-go-sdk/src/internal/backoff"
+	"github.com/H0llyW00dzZ/gspay-go-sdk/src/lifecycle"
+)
+
+// Default settings of a [Forwarder].
+const (
+	// DefaultTimeout is the default timeout of a single delivery attempt.
+	DefaultTimeout = 10 * time.Second
+	// DefaultAttempts is the default number of attempts per target.
+	DefaultAttempts = 5
+	// DefaultRetryWaitMin is the default wait after the first failed attempt.
+	DefaultRetryWaitMin = 500 * time.Millisecond
+	// DefaultRetryWaitMax caps the wait between attempts, jitter included.
+	DefaultRetryWaitMax = 30 * time.Second
+)
+
+// Target is an internal endpoint that receives forwarded events.
+type Target struct {
+	// URL is the endpoint the events are posted to.
+	URL string
+	// Secret is the HMAC-SHA256 key shared with the receiver.
+	Secret string
+}
+
+// Forwarder re-publishes verified GSPAY2 events to internal services, signed
+// with HMAC-SHA256 so they do not need to trust GSPAY2 signatures or IPs.
+//
+// Each event is posted as JSON to every target with [HeaderSignature],
+// [HeaderTimestamp] and [HeaderEventID]. Targets are delivered to
+// concurrently. A target that answers with a 2xx status has received the
+// event. Network errors, 408, 429 and 5xx responses are retried with
+// exponential backoff; other statuses fail immediately.
+type Forwarder struct {
+	targets      []Target
+	httpClient   *http.Client
+	attempts     int
+	retryWaitMin time.Duration
+	retryWaitMax time.Duration
+	filter       func(lifecycle.Event) bool
+	log          DeliveryLog
+	lang         i18n.Language
+	now          func() time.Time
+}
+
+// Option is a functional option for configuring the [Forwarder].
+type Option func(*Forwarder)
+
+// WithTarget adds a target. It can be given multiple times.
+func WithTarget(url, secret string) Option {
+	return func(f *Forwarder) {
+		f.targets = append(f.targets, Target{URL: url, Secret: secret})
+	}
+}
+
+// WithHTTPClient sets the HTTP client used for deliveries.
+// Default is an [http.Client] with [DefaultTimeout].
+func WithHTTPClient(c *http.Client) Option {
+	return func(f *Forwarder) {
+		if c != nil {
+			f.httpClient = c
+		}
+	}
+}
+
+// WithAttempts sets the number of attempts per target.
+// Default is [DefaultAttempts]. Values below 1 are ignored.
+func WithAttempts(n int) Option {
+	return func(f *Forwarder) {
+		if n > 0 {
+			f.attempts = n
+		}
+	}
+}
+
+// WithRetryWait sets the wait between delivery attempts to a target. It
+// starts at min and doubles with jitter, and is never more than max.
+// Default is [DefaultRetryWaitMin] and [DefaultRetryWaitMax]. Invalid values
+// are ignored.
+func WithRetryWait(min, max time.Duration) Option {
+	return func(f *Forwarder) {
+		if min > 0 && max >= min {
+			f.retryWaitMin = min
+			f.retryWaitMax = max
+		}
+	}
+}
+
+// WithFilter sets a function that selects the events to forward, e.g. only
+// final states. Default forwards every event.
+func WithFilter(fn func(lifecycle.Event) bool) Option {
+	return func(f *Forwarder) { f.filter = fn }
+}
+
+// WithDeliveryLog sets the log that records every attempt. Default is none.
+func WithDeliveryLog(l DeliveryLog) Option {
+	return func(f *Forwarder) { f.log = l }
+}
+
+// WithLanguage sets the language for localized delivery errors.
+// Default is [i18n.English].
+func WithLanguage(lang i18n.Language) Option {
+	return func(f *Forwarder) {
+		if lang.IsValid() {
+			f.lang = lang
+		}
+	}
+}
+
+// WithClock sets the function used for timestamps.
+// Default is [time.Now]. This is mainly useful for tests.
+func WithClock(now func() time.Time) Option {
+	return func(f *Forwarder) {
+		if now != nil {
+			f.now = now
+		}
+	}
+}
+
+// New creates a [Forwarder].
+//
+// [Forwarder.Publish] waits for every target, including retries, so it must
+// run behind a webhook queue (webhook.WithQueue). Subscribed to a router
+// without a queue, it would hold the GSPAY2 callback open and a failing
+// target would make GSPAY2 redeliver the callback.
+//
+// Example:
+//
+//	log := forward.NewMemoryLog(0)
+//	fwd := forward.New(
+//	    forward.WithTarget("https://orders.internal/gspay-events", os.Getenv("ORDERS_SECRET")),
+//	    forward.WithTarget("https://wallet.internal/gspay-events", os.Getenv("WALLET_SECRET")),
+//	    forward.WithFilter(func(ev lifecycle.Event) bool { return ev.State.IsFinal() }),
+//	    forward.WithDeliveryLog(log),
+//	)
+//	rt := webhook.NewRouter(
+//	    webhook.WithQueue(q),
+//	    webhook.WithSubscriber(fwd.Publish),
+//	    ...
+//	)
+func New(opts ...Option) *Forwarder {
+	f := &Forwarder{
+		httpClient:   &http.Client{Timeout: DefaultTimeout},
+		attempts:     DefaultAttempts,
+		retryWaitMin: DefaultRetryWaitMin,
+		retryWaitMax: DefaultRetryWaitMax,
+		lang:         i18n.English,
+		now:          time.Now,
+	}
+	for _, opt := range opts {
+		opt(f)
+	}
+	return f
+}
+
+// Publish forwards ev to every target concurrently and returns once each
+// target has received it or run out of attempts. The returned error joins
+// the failure of every target that did not receive the event.
+//
+// Publish has the signature of a webhook.Subscriber, so a failed forward can
+// be retried by the webhook queue. Targets that already received the event
+// then receive it again with the same [HeaderEventID].
+func (f *Forwarder) Publish(ctx context.Context, ev lifecycle.Event) error {
+	if f.filter != nil && !f.filter(ev) {
+		return nil
+	}
+	body, err := json.Marshal(ev)
+	if err != nil {
+		return err
+	}
+	id := EventID(ev)
+
+	errs := make([]error, len(f.targets))
+	var wg sync.WaitGroup
+	for i, target := range f.targets {
+		wg.Go(func() {
+			if err := f.deliver(ctx, target, id, ev.TransactionID, body); err != nil {
+				errs[i] = fmt.Errorf("%s: %w", target.URL, err)
+			}
+		})
+	}
+	wg.Wait()
+	return errors.Join(errs...)
+}
+
+// deliver posts body to target, retrying transient failures.
+func (f *Forwarder) deliver(ctx context.Context, target Target, id, transactionID string, body []byte) error {
+	var err error
+	for attempt := 1; attempt <= f.attempts; attempt++ {
+		if attempt > 1 {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(backoff.Exponential(f.retryWaitMin, f.retryWaitMax, attempt-1)):
+			}
+		}
+
+		var retry bool
+		d := Delivery{EventID: id, TransactionID: transactionID, Target: target.URL, Attempt: attempt, At: f.now()}
+		d.StatusCode, retry, err = f.post(ctx, target, id, body)
+		d.Duration = f.now().Sub(d.At)
+		if err != nil {
+			d.Error = err.Error()
+		}
+		if f.log != nil {
+			_ = f.log.Record(context.WithoutCancel(ctx), d)
+		}
+		if err == nil || !retry || ctx.Err() != nil {
+			return err
+		}
+	}
+	return err
+}
+
+// post makes a single signed delivery. It reports whether a failure may be retried.
+func (f *Forwarder) post(ctx context.Context, target Target, id string, body []byte) (int, bool, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, target.URL, bytes.NewReader(body))
+	if err != nil {
+		return 0, false, err
+	}
+	ts := f.now().Unix()
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(HeaderTimestamp, strconv.FormatInt(ts, 10))
+	req.Header.Set(HeaderSignature, Sign(target.Secret, ts, body))
+	req.Header.Set(HeaderEventID, id)
+
+	resp, err := f.httpClient.Do(req)
+	if err != nil {
+		return 0, true, err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return resp.StatusCode, false, nil
+	}
+	retry := resp.StatusCode >= 500 || resp.StatusCode == http.StatusRequestTimeout ||
+		resp.StatusCode == http.StatusTooManyRequests
+	return resp.StatusCode, retry, errors.New(f.lang, errors.ErrDeliveryFailed, resp.Status)
+}
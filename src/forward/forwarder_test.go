@@ -0,0 +1,193 @@
+// Copyright 2026 H0llyW00dzZ
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package forward
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/H0llyW00dzZ/gspay-go-sdk/src/errors"
+	"github.com/H0llyW00dzZ/gspay-go-sdk/src/i18n"
+	"github.com/H0llyW00dzZ/gspay-go-sdk/src/lifecycle"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testEvent(state lifecycle.State) lifecycle.Event {
+	return lifecycle.Event{
+		Kind:          lifecycle.KindPaymentIDR,
+		State:         state,
+		TransactionID: "TXN123456789",
+		ProviderID:    "123",
+		Amount:        "50000.00",
+		At:            time.Now(),
+		Source:        lifecycle.SourceCallback,
+	}
+}
+
+// receiver returns a server that verifies forwarded events and answers with
+// the given statuses in turn, repeating the last one.
+func receiver(t *testing.T, secret string, statuses ...int) (*httptest.Server, *atomic.Int32, chan lifecycle.Event) {
+	t.Helper()
+	var calls atomic.Int32
+	events := make(chan lifecycle.Event, 16)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := int(calls.Add(1))
+		ev, err := Verify(r, secret, 1<<20)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusUnauthorized)
+			return
+		}
+		assert.Equal(t, EventID(ev), r.Header.Get(HeaderEventID))
+		status := statuses[min(n, len(statuses))-1]
+		if status < 300 {
+			events <- ev
+		}
+		w.WriteHeader(status)
+	}))
+	t.Cleanup(srv.Close)
+	return srv, &calls, events
+}
+
+func TestPublish(t *testing.T) {
+	t.Run("delivers to every target", func(t *testing.T) {
+		a, _, aEvents := receiver(t, "secret-a", http.StatusOK)
+		b, _, bEvents := receiver(t, "secret-b", http.StatusNoContent)
+		log := NewMemoryLog(0)
+		f := New(WithTarget(a.URL, "secret-a"), WithTarget(b.URL, "secret-b"), WithDeliveryLog(log))
+
+		require.NoError(t, f.Publish(context.Background(), testEvent(lifecycle.StateSucceeded)))
+		assert.Equal(t, lifecycle.StateSucceeded, (<-aEvents).State)
+		assert.Equal(t, "TXN123456789", (<-bEvents).TransactionID)
+
+		deliveries := log.Deliveries()
+		require.Len(t, deliveries, 2)
+		assert.True(t, deliveries[0].OK())
+		assert.ElementsMatch(t, []int{http.StatusOK, http.StatusNoContent},
+			[]int{deliveries[0].StatusCode, deliveries[1].StatusCode})
+		assert.Empty(t, log.Failed())
+	})
+
+	t.Run("retries transient failures", func(t *testing.T) {
+		srv, calls, events := receiver(t, "secret", http.StatusServiceUnavailable, http.StatusTooManyRequests, http.StatusOK)
+		log := NewMemoryLog(0)
+		f := New(WithTarget(srv.URL, "secret"), WithRetryWait(time.Millisecond, 2*time.Millisecond), WithDeliveryLog(log))
+
+		require.NoError(t, f.Publish(context.Background(), testEvent(lifecycle.StateSucceeded)))
+		assert.EqualValues(t, 3, calls.Load())
+		assert.Len(t, events, 1)
+
+		deliveries := log.Deliveries()
+		require.Len(t, deliveries, 3)
+		assert.Equal(t, []int{1, 2, 3}, []int{deliveries[0].Attempt, deliveries[1].Attempt, deliveries[2].Attempt})
+		assert.Len(t, log.Failed(), 2)
+	})
+
+	t.Run("gives up after max attempts", func(t *testing.T) {
+		srv, calls, _ := receiver(t, "secret", http.StatusInternalServerError)
+		f := New(WithTarget(srv.URL, "secret"), WithAttempts(3), WithRetryWait(time.Millisecond, time.Millisecond))
+
+		err := f.Publish(context.Background(), testEvent(lifecycle.StateSucceeded))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), srv.URL)
+		assert.EqualValues(t, 3, calls.Load())
+	})
+
+	t.Run("does not retry permanent failures", func(t *testing.T) {
+		srv, calls, _ := receiver(t, "secret", http.StatusOK)
+		f := New(WithTarget(srv.URL, "wrong"), WithRetryWait(time.Millisecond, time.Millisecond))
+
+		err := f.Publish(context.Background(), testEvent(lifecycle.StateSucceeded))
+		require.ErrorIs(t, err, errors.ErrDeliveryFailed)
+		assert.Contains(t, err.Error(), "401")
+		assert.EqualValues(t, 1, calls.Load())
+	})
+
+	t.Run("one failing target does not block others", func(t *testing.T) {
+		bad, _, _ := receiver(t, "secret", http.StatusBadRequest)
+		good, _, events := receiver(t, "secret", http.StatusOK)
+		f := New(WithTarget(bad.URL, "secret"), WithTarget(good.URL, "secret"))
+
+		err := f.Publish(context.Background(), testEvent(lifecycle.StateSucceeded))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), bad.URL)
+		assert.NotContains(t, err.Error(), good.URL)
+		assert.Len(t, events, 1)
+	})
+
+	t.Run("slow target does not delay others", func(t *testing.T) {
+		release := make(chan struct{})
+		slow := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			<-release
+		}))
+		t.Cleanup(slow.Close)
+		t.Cleanup(func() { close(release) })
+		good, _, events := receiver(t, "secret", http.StatusOK)
+		f := New(WithTarget(slow.URL, "secret"), WithTarget(good.URL, "secret"))
+
+		go func() { _ = f.Publish(context.Background(), testEvent(lifecycle.StateSucceeded)) }()
+		select {
+		case <-events:
+		case <-time.After(5 * time.Second):
+			t.Fatal("good target waited for the slow one")
+		}
+	})
+
+	t.Run("localized delivery error", func(t *testing.T) {
+		srv, _, _ := receiver(t, "secret", http.StatusBadRequest)
+		f := New(WithTarget(srv.URL, "secret"), WithLanguage(i18n.Indonesian))
+
+		err := f.Publish(context.Background(), testEvent(lifecycle.StateSucceeded))
+		require.ErrorIs(t, err, errors.ErrDeliveryFailed)
+		assert.Contains(t, err.Error(), i18n.Get(i18n.Indonesian, i18n.MsgDeliveryFailed))
+	})
+
+	t.Run("filter skips events", func(t *testing.T) {
+		srv, calls, _ := receiver(t, "secret", http.StatusOK)
+		f := New(WithTarget(srv.URL, "secret"), WithFilter(func(ev lifecycle.Event) bool { return ev.State.IsFinal() }))
+
+		require.NoError(t, f.Publish(context.Background(), testEvent(lifecycle.StatePending)))
+		assert.Zero(t, calls.Load())
+		require.NoError(t, f.Publish(context.Background(), testEvent(lifecycle.StateFailed)))
+		assert.EqualValues(t, 1, calls.Load())
+	})
+
+	t.Run("context cancellation stops retries", func(t *testing.T) {
+		srv, calls, _ := receiver(t, "secret", http.StatusServiceUnavailable)
+		f := New(WithTarget(srv.URL, "secret"), WithRetryWait(time.Hour, time.Hour))
+
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+		err := f.Publish(ctx, testEvent(lifecycle.StateSucceeded))
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+		assert.EqualValues(t, 1, calls.Load())
+	})
+}
+
+func TestMemoryLog(t *testing.T) {
+	log := NewMemoryLog(2)
+	for i := 1; i <= 3; i++ {
+		require.NoError(t, log.Record(context.Background(), Delivery{Attempt: i}))
+	}
+	deliveries := log.Deliveries()
+	require.Len(t, deliveries, 2)
+	assert.Equal(t, 2, deliveries[0].Attempt)
+	assert.Equal(t, 3, deliveries[1].Attempt)
+}
@@ -0,0 +1,103 @@
+// Copyright 2026 H0llyW00dzZ
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package forward
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"time"
+)
+
+// DefaultLogSize is the default number of deliveries kept by a [MemoryLog].
+const DefaultLogSize = 1000
+
+// Delivery records one attempt to forward an event to a target.
+type Delivery struct {
+	// EventID is the [HeaderEventID] of the forwarded event.
+	EventID string `json:"event_id"`
+	// TransactionID is the transaction of the forwarded event.
+	TransactionID string `json:"transaction_id"`
+	// Target is the target URL.
+	Target string `json:"target"`
+	// Attempt is the 1-based attempt number.
+	Attempt int `json:"attempt"`
+	// StatusCode is the response status, or 0 if no response was received.
+	StatusCode int `json:"status_code,omitempty"`
+	// Error describes a failed attempt.
+	Error string `json:"error,omitempty"`
+	// At is when the attempt started.
+	At time.Time `json:"at"`
+	// Duration is how long the attempt took.
+	Duration time.Duration `json:"duration"`
+}
+
+// OK reports whether the attempt succeeded.
+func (d Delivery) OK() bool { return d.Error == "" }
+
+// DeliveryLog records every forwarding attempt.
+//
+// Implementations must be safe for concurrent use. Built-in implementation:
+// [MemoryLog]. Custom logs (SQL, metrics, etc.) only need to implement Record.
+type DeliveryLog interface {
+	Record(ctx context.Context, d Delivery) error
+}
+
+// MemoryLog is a [DeliveryLog] that keeps the most recent deliveries in memory.
+type MemoryLog struct {
+	mu    sync.Mutex
+	size  int
+	items []Delivery
+}
+
+// NewMemoryLog creates a [MemoryLog] that keeps the last size deliveries.
+// A size below 1 uses [DefaultLogSize].
+func NewMemoryLog(size int) *MemoryLog {
+	if size < 1 {
+		size = DefaultLogSize
+	}
+	return &MemoryLog{size: size}
+}
+
+// Record implements [DeliveryLog].
+func (l *MemoryLog) Record(_ context.Context, d Delivery) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if len(l.items) == l.size {
+		l.items = slices.Delete(l.items, 0, 1)
+	}
+	l.items = append(l.items, d)
+	return nil
+}
+
+// Deliveries returns the recorded deliveries, oldest first.
+func (l *MemoryLog) Deliveries() []Delivery {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return slices.Clone(l.items)
+}
+
+// Failed returns the recorded failed attempts, oldest first.
+func (l *MemoryLog) Failed() []Delivery {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	var failed []Delivery
+	for _, d := range l.items {
+		if !d.OK() {
+			failed = append(failed, d)
+		}
+	}
+	return failed
+}
@@ -0,0 +1,120 @@
+// Copyright 2026 H0llyW00dzZ
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package forward
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/H0llyW00dzZ/gspay-go-sdk/src/errors"
+	"github.com/H0llyW00dzZ/gspay-go-sdk/src/i18n"
+	"github.com/H0llyW00dzZ/gspay-go-sdk/src/lifecycle"
+)
+
+// Headers set on every forwarded event.
+const (
+	// HeaderSignature carries "sha256=" and the hex HMAC-SHA256 of the
+	// timestamp, a dot, and the body.
+	HeaderSignature = "X-GSPay-Signature"
+	// HeaderTimestamp carries the Unix time in seconds the request was signed.
+	HeaderTimestamp = "X-GSPay-Timestamp"
+	// HeaderEventID identifies the event. Retries and re-publications of the
+	// same event carry the same ID, so receivers can drop duplicates.
+	HeaderEventID = "X-GSPay-Event-ID"
+)
+
+// DefaultTolerance is the default maximum age of a signed request accepted by [Verify].
+const DefaultTolerance = 5 * time.Minute
+
+// signaturePrefix prefixes the hex digest in [HeaderSignature].
+const signaturePrefix = "sha256="
+
+// Sign returns the [HeaderSignature] value for body signed at timestamp.
+func Sign(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return signaturePrefix + hex.EncodeToString(mac.Sum(nil))
+}
+
+// VerifySignature checks a signature produced by [Sign] and that timestamp
+// is within tolerance of now.
+//
+// It returns an error wrapping [errors.ErrInvalidSignature] if the signature
+// does not match, or [errors.ErrStaleTimestamp] if the timestamp is missing,
+// malformed, or outside tolerance.
+func VerifySignature(secret string, body []byte, timestamp, signature string, now time.Time, tolerance time.Duration, lang i18n.Language) error {
+	ts, err := strconv.ParseInt(strings.TrimSpace(timestamp), 10, 64)
+	if err != nil {
+		return errors.New(lang, errors.ErrStaleTimestamp, strconv.Quote(timestamp))
+	}
+	if age := now.Sub(time.Unix(ts, 0)); age > tolerance || age < -tolerance {
+		return errors.New(lang, errors.ErrStaleTimestamp, age.Round(time.Second).String())
+	}
+	if !hmac.Equal([]byte(Sign(secret, ts, body)), []byte(strings.TrimSpace(signature))) {
+		return errors.New(lang, errors.ErrInvalidSignature)
+	}
+	return nil
+}
+
+// Verify reads and verifies a forwarded event on the receiving side, using
+// [DefaultTolerance] and English error messages. The request body is
+// consumed; a body over maxBodySize bytes returns an [*http.MaxBytesError].
+//
+// Example:
+//
+//	http.HandleFunc("POST /gspay-events", func(w http.ResponseWriter, r *http.Request) {
+//	    ev, err := forward.Verify(r, os.Getenv("GSPAY_FORWARD_SECRET"), 1<<20)
+//	    if err != nil {
+//	        http.Error(w, err.Error(), http.StatusUnauthorized)
+//	        return
+//	    }
+//	    if ev.State == lifecycle.StateSucceeded {
+//	        // Settle the order, deduplicating on r.Header.Get(forward.HeaderEventID)
+//	    }
+//	})
+func Verify(r *http.Request, secret string, maxBodySize int64) (lifecycle.Event, error) {
+	body, err := io.ReadAll(http.MaxBytesReader(nil, r.Body, maxBodySize))
+	if err != nil {
+		return lifecycle.Event{}, err
+	}
+	if err := VerifySignature(secret, body, r.Header.Get(HeaderTimestamp), r.Header.Get(HeaderSignature), time.Now(), DefaultTolerance, i18n.English); err != nil {
+		return lifecycle.Event{}, err
+	}
+
+	var ev lifecycle.Event
+	dec := json.NewDecoder(bytes.NewReader(body))
+	dec.UseNumber()
+	if err := dec.Decode(&ev); err != nil {
+		return lifecycle.Event{}, errors.New(i18n.English, errors.ErrInvalidJSON, err)
+	}
+	return ev, nil
+}
+
+// EventID returns the stable ID of ev sent in [HeaderEventID]. It depends
+// only on the transaction and the state, not on when the event was observed.
+func EventID(ev lifecycle.Event) string {
+	sum := sha256.Sum256([]byte(string(ev.Kind) + "\x00" + ev.TransactionID + "\x00" + string(ev.State)))
+	return hex.EncodeToString(sum[:16])
+}
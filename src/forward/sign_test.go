@@ -0,0 +1,114 @@
+// Copyright 2026 H0llyW00dzZ
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package forward
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/H0llyW00dzZ/gspay-go-sdk/src/errors"
+	"github.com/H0llyW00dzZ/gspay-go-sdk/src/i18n"
+	"github.com/H0llyW00dzZ/gspay-go-sdk/src/lifecycle"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVerifySignature(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	body := []byte(`{"state":"succeeded"}`)
+	ts := strconv.FormatInt(now.Unix(), 10)
+	sig := Sign("secret", now.Unix(), body)
+
+	t.Run("valid", func(t *testing.T) {
+		assert.Contains(t, sig, "sha256=")
+		assert.NoError(t, VerifySignature("secret", body, ts, sig, now.Add(time.Minute), DefaultTolerance, i18n.English))
+	})
+
+	t.Run("wrong secret", func(t *testing.T) {
+		err := VerifySignature("other", body, ts, sig, now, DefaultTolerance, i18n.English)
+		assert.ErrorIs(t, err, errors.ErrInvalidSignature)
+	})
+
+	t.Run("tampered body", func(t *testing.T) {
+		err := VerifySignature("secret", []byte(`{"state":"failed"}`), ts, sig, now, DefaultTolerance, i18n.English)
+		assert.ErrorIs(t, err, errors.ErrInvalidSignature)
+	})
+
+	t.Run("stale timestamp", func(t *testing.T) {
+		err := VerifySignature("secret", body, ts, sig, now.Add(DefaultTolerance+time.Second), DefaultTolerance, i18n.English)
+		assert.ErrorIs(t, err, errors.ErrStaleTimestamp)
+	})
+
+	t.Run("future timestamp", func(t *testing.T) {
+		err := VerifySignature("secret", body, ts, sig, now.Add(-DefaultTolerance-time.Second), DefaultTolerance, i18n.English)
+		assert.ErrorIs(t, err, errors.ErrStaleTimestamp)
+	})
+
+	t.Run("missing timestamp", func(t *testing.T) {
+		err := VerifySignature("secret", body, "", sig, now, DefaultTolerance, i18n.Indonesian)
+		assert.ErrorIs(t, err, errors.ErrStaleTimestamp)
+		assert.Contains(t, err.Error(), "toleransi")
+	})
+}
+
+func TestVerify(t *testing.T) {
+	ev := lifecycle.Event{Kind: lifecycle.KindPaymentIDR, State: lifecycle.StateSucceeded, TransactionID: "TXN1"}
+	body := []byte(`{"kind":"payment_idr","state":"succeeded","transaction_id":"TXN1","at":"2026-01-01T00:00:00Z","source":"callback"}`)
+
+	newRequest := func(secret string) *http.Request {
+		ts := time.Now().Unix()
+		r := httptest.NewRequest(http.MethodPost, "/events", bytes.NewReader(body))
+		r.Header.Set(HeaderTimestamp, strconv.FormatInt(ts, 10))
+		r.Header.Set(HeaderSignature, Sign(secret, ts, body))
+		r.Header.Set(HeaderEventID, EventID(ev))
+		return r
+	}
+
+	t.Run("valid", func(t *testing.T) {
+		got, err := Verify(newRequest("secret"), "secret", 1<<20)
+		require.NoError(t, err)
+		assert.Equal(t, ev.State, got.State)
+		assert.Equal(t, ev.TransactionID, got.TransactionID)
+		assert.Equal(t, lifecycle.SourceCallback, got.Source)
+	})
+
+	t.Run("invalid signature", func(t *testing.T) {
+		_, err := Verify(newRequest("other"), "secret", 1<<20)
+		assert.ErrorIs(t, err, errors.ErrInvalidSignature)
+	})
+
+	t.Run("body too large", func(t *testing.T) {
+		_, err := Verify(newRequest("secret"), "secret", 8)
+		var tooLarge *http.MaxBytesError
+		assert.ErrorAs(t, err, &tooLarge)
+	})
+}
+
+func TestEventID(t *testing.T) {
+	a := lifecycle.Event{Kind: lifecycle.KindPaymentIDR, State: lifecycle.StateSucceeded, TransactionID: "TXN1", At: time.Unix(1, 0)}
+	b := a
+	b.At = time.Unix(2, 0)
+	b.Source = lifecycle.SourceStatus
+	assert.Equal(t, EventID(a), EventID(b))
+	assert.Len(t, EventID(a), 32)
+
+	b.State = lifecycle.StateFailed
+	assert.NotEqual(t, EventID(a), EventID(b))
+}
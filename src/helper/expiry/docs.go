@@ -0,0 +1,26 @@
+// Copyright 2026 H0llyW00dzZ
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package expiry parses the expire dates returned by GSPAY2.
+//
+// GSPAY2 sends expire dates as strings, usually in server local time (WIB,
+// UTC+7) without an offset. [Parse] accepts the layouts in [Layouts] as well
+// as Unix timestamps, and interprets dates without an offset in the given
+// location:
+//
+//	t, err := expiry.Parse("2026-01-26 15:00:00", constants.DefaultServerLocation, i18n.English)
+//	// 2026-01-26 15:00:00 +0700 WIB
+//
+// [Remaining] and [IsExpired] turn the parsed time into a countdown.
+package expiry
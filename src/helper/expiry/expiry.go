@@ -0,0 +1,77 @@
+// Copyright 2026 H0llyW00dzZ
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package expiry
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/H0llyW00dzZ/gspay-go-sdk/src/constants"
+	"github.com/H0llyW00dzZ/gspay-go-sdk/src/errors"
+	"github.com/H0llyW00dzZ/gspay-go-sdk/src/i18n"
+)
+
+// Layouts are the expire date layouts accepted by [Parse], tried in order.
+// Fractional seconds are accepted after the seconds of any layout.
+var Layouts = []string{
+	"2006-01-02 15:04:05",
+	time.RFC3339,
+	"2006-01-02T15:04:05",
+	"2006-01-02 15:04:05Z07:00",
+	"2006-01-02 15:04:05 -0700",
+	"2006-01-02 15:04",
+	"2006/01/02 15:04:05",
+	"02-01-2006 15:04:05",
+}
+
+// Parse parses an expire date. Dates without an offset are interpreted in
+// loc, or [constants.DefaultServerLocation] if loc is nil. A string of 10 or
+// 13 digits is read as a Unix timestamp in seconds or milliseconds.
+//
+// An unknown format returns an error wrapping [errors.ErrInvalidExpireDate]
+// that quotes the value.
+func Parse(value string, loc *time.Location, lang i18n.Language) (time.Time, error) {
+	if loc == nil {
+		loc = constants.DefaultServerLocation
+	}
+	value = strings.TrimSpace(value)
+
+	if n, err := strconv.ParseInt(value, 10, 64); err == nil {
+		switch len(value) {
+		case 10:
+			return time.Unix(n, 0).In(loc), nil
+		case 13:
+			return time.UnixMilli(n).In(loc), nil
+		}
+	}
+	for _, layout := range Layouts {
+		if t, err := time.ParseInLocation(layout, value, loc); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, errors.New(lang, errors.ErrInvalidExpireDate, strconv.Quote(value))
+}
+
+// Remaining returns the time left from now until expiresAt, or zero if it
+// has passed.
+func Remaining(expiresAt, now time.Time) time.Duration {
+	return max(expiresAt.Sub(now), 0)
+}
+
+// IsExpired reports whether expiresAt is at or before now.
+func IsExpired(expiresAt, now time.Time) bool {
+	return !now.Before(expiresAt)
+}
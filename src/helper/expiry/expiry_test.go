@@ -0,0 +1,99 @@
+// Copyright 2026 H0llyW00dzZ
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package expiry
+
+import (
+	"testing"
+	"time"
+
+	"github.com/H0llyW00dzZ/gspay-go-sdk/src/constants"
+	"github.com/H0llyW00dzZ/gspay-go-sdk/src/errors"
+	"github.com/H0llyW00dzZ/gspay-go-sdk/src/i18n"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	want := time.Date(2026, 1, 26, 15, 0, 0, 0, constants.DefaultServerLocation)
+
+	t.Run("accepts known layouts", func(t *testing.T) {
+		for _, value := range []string{
+			"2026-01-26 15:00:00",
+			"2026-01-26T15:00:00",
+			"2026-01-26 15:00",
+			"2026/01/26 15:00:00",
+			"26-01-2026 15:00:00",
+			" 2026-01-26 15:00:00 ",
+			"2026-01-26T08:00:00Z",
+			"2026-01-26T15:00:00+07:00",
+			"2026-01-26 08:00:00Z",
+			"2026-01-26 15:00:00 +0700",
+			"2026-01-26 15:00:00.000",
+		} {
+			got, err := Parse(value, nil, i18n.English)
+			require.NoError(t, err, value)
+			assert.True(t, want.Equal(got), "%s: got %s", value, got)
+		}
+	})
+
+	t.Run("accepts unix timestamps", func(t *testing.T) {
+		got, err := Parse("1769414400", nil, i18n.English)
+		require.NoError(t, err)
+		assert.True(t, want.Equal(got))
+		assert.Equal(t, constants.DefaultServerLocation, got.Location())
+
+		got, err = Parse("1769414400000", nil, i18n.English)
+		require.NoError(t, err)
+		assert.True(t, want.Equal(got))
+	})
+
+	t.Run("uses the given location", func(t *testing.T) {
+		got, err := Parse("2026-01-26 15:00:00", time.UTC, i18n.English)
+		require.NoError(t, err)
+		assert.Equal(t, time.Date(2026, 1, 26, 15, 0, 0, 0, time.UTC), got)
+
+		// An explicit offset wins over the location.
+		got, err = Parse("2026-01-26T15:00:00+07:00", time.UTC, i18n.English)
+		require.NoError(t, err)
+		assert.True(t, want.Equal(got))
+	})
+
+	t.Run("rejects unknown formats", func(t *testing.T) {
+		for _, value := range []string{"", "tomorrow", "26 Jan 2026", "12345"} {
+			_, err := Parse(value, nil, i18n.English)
+			assert.ErrorIs(t, err, errors.ErrInvalidExpireDate, value)
+		}
+
+		_, err := Parse("tomorrow", nil, i18n.English)
+		assert.Contains(t, err.Error(), `"tomorrow"`)
+		_, err = Parse("tomorrow", nil, i18n.Indonesian)
+		assert.Contains(t, err.Error(), "tidak dikenali")
+	})
+}
+
+func TestRemaining(t *testing.T) {
+	now := time.Date(2026, 1, 26, 15, 0, 0, 0, time.UTC)
+	assert.Equal(t, 10*time.Minute, Remaining(now.Add(10*time.Minute), now))
+	assert.Zero(t, Remaining(now, now))
+	assert.Zero(t, Remaining(now.Add(-time.Minute), now))
+}
+
+func TestIsExpired(t *testing.T) {
+	now := time.Date(2026, 1, 26, 15, 0, 0, 0, time.UTC)
+	assert.False(t, IsExpired(now.Add(time.Second), now))
+	assert.True(t, IsExpired(now, now))
+	assert.True(t, IsExpired(now.Add(-time.Second), now))
+}
@@ -20,28 +20,83 @@ type MessageKey string
 // Message keys for SDK errors and validation messages.
 const (
 	// Sentinel error messages.
-	MsgInvalidTransactionID MessageKey = "invalid_transaction_id"
-	MsgInvalidAmount        MessageKey = "invalid_amount"
-	MsgInvalidBankCode      MessageKey = "invalid_bank_code"
-	MsgInvalidSignature     MessageKey = "invalid_signature"
-	MsgMissingCallbackField MessageKey = "missing_callback_field"
-	MsgEmptyResponse        MessageKey = "empty_response"
-	MsgInvalidJSON          MessageKey = "invalid_json"
-	MsgRequestFailed        MessageKey = "request_failed"
-	MsgIPNotWhitelisted     MessageKey = "ip_not_whitelisted"
-	MsgInvalidIPAddress     MessageKey = "invalid_ip_address"
-	MsgRateLimited          MessageKey = "rate_limited"
-	MsgEmptyQRContent       MessageKey = "empty_qr_content"
-	MsgQREncodeFailed       MessageKey = "qr_encode_failed"
+	MsgInvalidTransactionID   MessageKey = "invalid_transaction_id"
+	MsgInvalidAmount          MessageKey = "invalid_amount"
+	MsgInvalidBankCode        MessageKey = "invalid_bank_code"
+	MsgInvalidSignature       MessageKey = "invalid_signature"
+	MsgMissingCallbackField   MessageKey = "missing_callback_field"
+	MsgEmptyResponse          MessageKey = "empty_response"
+	MsgInvalidJSON            MessageKey = "invalid_json"
+	MsgRequestFailed          MessageKey = "request_failed"
+	MsgIPNotWhitelisted       MessageKey = "ip_not_whitelisted"
+	MsgInvalidIPAddress       MessageKey = "invalid_ip_address"
+	MsgRateLimited            MessageKey = "rate_limited"
+	MsgEmptyQRContent         MessageKey = "empty_qr_content"
+	MsgQREncodeFailed         MessageKey = "qr_encode_failed"
+	MsgRecordNotFound         MessageKey = "record_not_found"
+	MsgUnsupportedKind        MessageKey = "unsupported_kind"
+	MsgInsufficientBalance    MessageKey = "insufficient_balance"
+	MsgUnknownMerchant        MessageKey = "unknown_merchant"
+	MsgDuplicateMerchant      MessageKey = "duplicate_merchant"
+	MsgInvalidMerchantConfig  MessageKey = "invalid_merchant_config"
+	MsgNoMatchingMerchant     MessageKey = "no_matching_merchant"
+	MsgCredentialsUnavailable MessageKey = "credentials_unavailable"
+	MsgInvalidConfig          MessageKey = "invalid_config"
+	MsgResponseTooLarge       MessageKey = "response_too_large"
+	MsgDuplicateTransaction   MessageKey = "duplicate_transaction"
+	MsgBankMaintenance        MessageKey = "bank_maintenance"
+	MsgAccountMismatch        MessageKey = "account_mismatch"
+
+	// Business error explanations.
+	MsgExplainDuplicateTransaction MessageKey = "explain_duplicate_transaction"
+	MsgExplainInvalidSignature     MessageKey = "explain_invalid_signature"
+	MsgExplainInsufficientBalance  MessageKey = "explain_insufficient_balance"
+	MsgExplainBankMaintenance      MessageKey = "explain_bank_maintenance"
+	MsgExplainAccountMismatch      MessageKey = "explain_account_mismatch"
+	MsgInvalidUsername             MessageKey = "invalid_username"
+	MsgInvalidAccountName          MessageKey = "invalid_account_name"
+	MsgInvalidAccountNumber        MessageKey = "invalid_account_number"
+	MsgInvalidDescription          MessageKey = "invalid_description"
+	MsgInvalidChannel              MessageKey = "invalid_channel"
+	MsgInvalidExpireDate           MessageKey = "invalid_expire_date"
+	MsgIllegalTransition           MessageKey = "illegal_transition"
+	MsgEventMismatch               MessageKey = "event_mismatch"
+	MsgUnknownCallback             MessageKey = "unknown_callback"
+	MsgStaleTimestamp              MessageKey = "stale_timestamp"
+	MsgCallbackTooLarge            MessageKey = "callback_too_large"
+	MsgDuplicateKey                MessageKey = "duplicate_key"
+	MsgDeliveryFailed              MessageKey = "delivery_failed"
 
 	// Validation error messages.
-	MsgMinAmountIDR          MessageKey = "min_amount_idr"
-	MsgMinAmountUSDT         MessageKey = "min_amount_usdt"
-	MsgMinPayoutAmountIDR    MessageKey = "min_payout_amount_idr"
-	MsgInvalidAmountFormat   MessageKey = "invalid_amount_format"
-	MsgValidationErrorFormat MessageKey = "validation_error_format"
-	MsgAPIErrorFormat        MessageKey = "api_error_format"
-	MsgAPIErrorFormatNoURL   MessageKey = "api_error_format_no_url"
+	MsgMinAmountIDR              MessageKey = "min_amount_idr"
+	MsgMinAmountUSDT             MessageKey = "min_amount_usdt"
+	MsgMinPayoutAmountIDR        MessageKey = "min_payout_amount_idr"
+	MsgInvalidAmountFormat       MessageKey = "invalid_amount_format"
+	MsgTransactionIDLength       MessageKey = "transaction_id_length"
+	MsgTransactionIDChars        MessageKey = "transaction_id_chars"
+	MsgDescriptionTooLong        MessageKey = "description_too_long"
+	MsgAccountNumberDigits       MessageKey = "account_number_digits"
+	MsgAccountNumberLength       MessageKey = "account_number_length"
+	MsgAccountNumberRange        MessageKey = "account_number_range"
+	MsgAccountNumberPhone        MessageKey = "account_number_phone"
+	MsgChannelAmountRange        MessageKey = "channel_amount_range"
+	MsgChannelMinAmount          MessageKey = "channel_min_amount"
+	MsgValidationErrorFormat     MessageKey = "validation_error_format"
+	MsgValidationSubject         MessageKey = "validation_subject"
+	MsgAmountExceedsBalance      MessageKey = "amount_exceeds_balance"
+	MsgAPIErrorFormat            MessageKey = "api_error_format"
+	MsgAPIErrorFormatNoURL       MessageKey = "api_error_format_no_url"
+	MsgProblemValidationTitle    MessageKey = "problem_validation_title"
+	MsgProblemAPITitle           MessageKey = "problem_api_title"
+	MsgConfigRequired            MessageKey = "config_required"
+	MsgConfigTimeoutTooShort     MessageKey = "config_timeout_too_short"
+	MsgConfigNegative            MessageKey = "config_negative"
+	MsgConfigNotPositive         MessageKey = "config_not_positive"
+	MsgConfigRetryWaitOrder      MessageKey = "config_retry_wait_order"
+	MsgConfigInvalidURL          MessageKey = "config_invalid_url"
+	MsgConfigInvalidIP           MessageKey = "config_invalid_ip"
+	MsgConfigUnsupportedLanguage MessageKey = "config_unsupported_language"
+	MsgConfigInvalidValue        MessageKey = "config_invalid_value"
 
 	// Request retry messages.
 	MsgRequestFailedAfterRetries MessageKey = "request_failed_after_retries"
@@ -61,6 +116,7 @@ const (
 	LogIDRSigVerifyFailedFormat   MessageKey = "log_idr_sig_verify_failed_format"
 	LogIDRSigVerifyFailedMismatch MessageKey = "log_idr_sig_verify_failed_mismatch"
 	LogIDRCallbackIPFailed        MessageKey = "log_idr_callback_ip_failed"
+	LogIDRChannelDropped          MessageKey = "log_idr_channel_dropped"
 
 	// Log messages - USDT Payment.
 	LogCreatingUSDTPayment         MessageKey = "log_creating_usdt_payment"
@@ -75,22 +131,34 @@ const (
 	LogUSDTCallbackIPFailed        MessageKey = "log_usdt_callback_ip_failed"
 
 	// Log messages - IDR Payout.
-	LogCreatingIDRPayout          MessageKey = "log_creating_idr_payout"
-	LogIDRPayoutCreated           MessageKey = "log_idr_payout_created"
-	LogQueryingIDRPayoutStatus    MessageKey = "log_querying_idr_payout_status"
-	LogIDRPayoutStatusRetrieved   MessageKey = "log_idr_payout_status_retrieved"
-	LogVerifyingIDRPayoutSig      MessageKey = "log_verifying_idr_payout_signature"
-	LogIDRPayoutSigVerified       MessageKey = "log_idr_payout_signature_verified"
-	LogVerifyingIDRPayoutCallback MessageKey = "log_verifying_idr_payout_callback"
-	LogIDRPayoutCallbackVerified  MessageKey = "log_idr_payout_callback_verified"
-	LogIDRPayoutSigFailedMissing  MessageKey = "log_idr_payout_sig_failed_missing"
-	LogIDRPayoutSigFailedFormat   MessageKey = "log_idr_payout_sig_failed_format"
-	LogIDRPayoutSigFailedMismatch MessageKey = "log_idr_payout_sig_failed_mismatch"
-	LogIDRPayoutCallbackIPFailed  MessageKey = "log_idr_payout_callback_ip_failed"
+	LogCreatingIDRPayout           MessageKey = "log_creating_idr_payout"
+	LogIDRPayoutCreated            MessageKey = "log_idr_payout_created"
+	LogPayoutInsufficientBalance   MessageKey = "log_payout_insufficient_balance"
+	LogPayoutBalanceUnavailable    MessageKey = "log_payout_balance_unavailable"
+	LogQueryingIDRPayoutStatus     MessageKey = "log_querying_idr_payout_status"
+	LogIDRPayoutStatusRetrieved    MessageKey = "log_idr_payout_status_retrieved"
+	LogVerifyingIDRPayoutSig       MessageKey = "log_verifying_idr_payout_signature"
+	LogIDRPayoutSigVerified        MessageKey = "log_idr_payout_signature_verified"
+	LogVerifyingIDRPayoutStatusSig MessageKey = "log_verifying_idr_payout_status_signature"
+	LogIDRPayoutStatusSigVerified  MessageKey = "log_idr_payout_status_signature_verified"
+	LogVerifyingIDRPayoutCallback  MessageKey = "log_verifying_idr_payout_callback"
+	LogIDRPayoutCallbackVerified   MessageKey = "log_idr_payout_callback_verified"
+	LogIDRPayoutSigFailedMissing   MessageKey = "log_idr_payout_sig_failed_missing"
+	LogIDRPayoutSigFailedFormat    MessageKey = "log_idr_payout_sig_failed_format"
+	LogIDRPayoutSigFailedMismatch  MessageKey = "log_idr_payout_sig_failed_mismatch"
+	LogIDRPayoutCallbackIPFailed   MessageKey = "log_idr_payout_callback_ip_failed"
 
 	// Log messages - Balance.
-	LogQueryingBalance  MessageKey = "log_querying_balance"
-	LogBalanceRetrieved MessageKey = "log_balance_retrieved"
+	LogQueryingBalance   MessageKey = "log_querying_balance"
+	LogBalanceRetrieved  MessageKey = "log_balance_retrieved"
+	LogBalanceLow        MessageKey = "log_balance_low"
+	LogBalanceRecovered  MessageKey = "log_balance_recovered"
+	LogBalancePollFailed MessageKey = "log_balance_poll_failed"
+
+	// Log messages - Signature.
+	LogSecretKeyMatched         MessageKey = "log_secret_key_matched"
+	LogPreviousSecretKeyMatched MessageKey = "log_previous_secret_key_matched"
+	LogCredentialsUnavailable   MessageKey = "log_credentials_unavailable"
 
 	// Log messages - HTTP Request.
 	LogHTTPErrorResponse   MessageKey = "log_http_error_response"
@@ -110,28 +178,83 @@ const (
 var translations = map[Language]map[MessageKey]string{
 	English: {
 		// Sentinel errors
-		MsgInvalidTransactionID: "transaction ID must be 5-20 characters",
-		MsgInvalidAmount:        "invalid payment amount",
-		MsgInvalidBankCode:      "invalid bank code",
-		MsgInvalidSignature:     "invalid signature",
-		MsgMissingCallbackField: "missing required callback field",
-		MsgEmptyResponse:        "empty response from API",
-		MsgInvalidJSON:          "invalid JSON response",
-		MsgRequestFailed:        "request failed",
-		MsgIPNotWhitelisted:     "IP address not whitelisted",
-		MsgInvalidIPAddress:     "invalid IP address format",
-		MsgRateLimited:          "rate limited by API",
-		MsgEmptyQRContent:       "QR code content must not be empty",
-		MsgQREncodeFailed:       "failed to encode QR code",
+		MsgInvalidTransactionID:   "transaction ID must be 5-20 characters",
+		MsgInvalidAmount:          "invalid payment amount",
+		MsgInvalidBankCode:        "invalid bank code",
+		MsgInvalidSignature:       "invalid signature",
+		MsgMissingCallbackField:   "missing required callback field",
+		MsgEmptyResponse:          "empty response from API",
+		MsgInvalidJSON:            "invalid JSON response",
+		MsgRequestFailed:          "request failed",
+		MsgIPNotWhitelisted:       "IP address not whitelisted",
+		MsgInvalidIPAddress:       "invalid IP address format",
+		MsgRateLimited:            "rate limited by API",
+		MsgEmptyQRContent:         "QR code content must not be empty",
+		MsgQREncodeFailed:         "failed to encode QR code",
+		MsgRecordNotFound:         "ledger record not found",
+		MsgUnsupportedKind:        "unsupported transaction kind",
+		MsgInsufficientBalance:    "insufficient balance",
+		MsgUnknownMerchant:        "unknown merchant",
+		MsgDuplicateMerchant:      "merchant already registered",
+		MsgInvalidMerchantConfig:  "invalid merchant configuration",
+		MsgNoMatchingMerchant:     "no merchant matches the callback",
+		MsgCredentialsUnavailable: "credentials unavailable",
+		MsgInvalidConfig:          "invalid client configuration",
+		MsgResponseTooLarge:       "response body exceeds the maximum size",
+		MsgDuplicateTransaction:   "duplicate transaction ID",
+		MsgBankMaintenance:        "bank is under maintenance",
+		MsgAccountMismatch:        "account name does not match account number",
+
+		// Business error explanations
+		MsgExplainDuplicateTransaction: "The transaction ID was already used. Query the existing transaction's status instead of creating it again, or use a new transaction ID.",
+		MsgExplainInvalidSignature:     "GSPAY2 could not verify the request signature. Check that the secret key matches the operator account and that the amount is formatted as required.",
+		MsgExplainInsufficientBalance:  "The operator settlement balance cannot cover the payout. Top up the balance and retry.",
+		MsgExplainBankMaintenance:      "The target bank or channel is temporarily under maintenance. Retry later or offer another bank or channel.",
+		MsgExplainAccountMismatch:      "The account holder name does not match the account number at the bank. Ask the user to check their account details.",
+		MsgInvalidUsername:             "username is required",
+		MsgInvalidAccountName:          "account name is required",
+		MsgInvalidAccountNumber:        "account number must contain only digits",
+		MsgInvalidDescription:          "description is too long",
+		MsgInvalidChannel:              "unsupported payment channel",
+		MsgInvalidExpireDate:           "unrecognized expire date format",
+		MsgIllegalTransition:           "illegal state transition",
+		MsgEventMismatch:               "event belongs to a different transaction",
+		MsgUnknownCallback:             "unrecognized callback payload",
+		MsgStaleTimestamp:              "timestamp outside the allowed tolerance",
+		MsgCallbackTooLarge:            "callback body exceeds the maximum size",
+		MsgDuplicateKey:                "duplicate JSON key",
+		MsgDeliveryFailed:              "delivery rejected by target",
 
 		// Validation errors
-		MsgMinAmountIDR:          "minimum amount is 10000 IDR",
-		MsgMinAmountUSDT:         "minimum amount is 1.00 USDT",
-		MsgMinPayoutAmountIDR:    "minimum payout amount is 10000 IDR",
-		MsgInvalidAmountFormat:   "invalid amount format",
-		MsgValidationErrorFormat: "gspay: validation error for %s: %s",
-		MsgAPIErrorFormat:        "gspay: API error %d on %s: %s",
-		MsgAPIErrorFormatNoURL:   "gspay: API error %d: %s",
+		MsgMinAmountIDR:              "minimum amount is 10000 IDR",
+		MsgMinAmountUSDT:             "minimum amount is 1.00 USDT",
+		MsgMinPayoutAmountIDR:        "minimum payout amount is 10000 IDR",
+		MsgInvalidAmountFormat:       "invalid amount format",
+		MsgTransactionIDLength:       "must be between %d and %d characters",
+		MsgTransactionIDChars:        "may only contain letters, digits, '-' and '_'",
+		MsgDescriptionTooLong:        "must be at most %d characters",
+		MsgAccountNumberDigits:       "account number for %s must contain only digits",
+		MsgAccountNumberLength:       "account number for %s must be %d digits",
+		MsgAccountNumberRange:        "account number for %s must be %d to %d digits",
+		MsgAccountNumberPhone:        "account number for %s must be a mobile number starting with %s",
+		MsgChannelAmountRange:        "must be between %d and %d for %s",
+		MsgChannelMinAmount:          "must be at least %d for %s",
+		MsgValidationErrorFormat:     "gspay: validation error for %s: %s",
+		MsgValidationSubject:         "%s: %s",
+		MsgAmountExceedsBalance:      "insufficient balance: %d > %.2f",
+		MsgAPIErrorFormat:            "gspay: API error %d on %s: %s",
+		MsgAPIErrorFormatNoURL:       "gspay: API error %d: %s",
+		MsgProblemValidationTitle:    "request validation failed",
+		MsgProblemAPITitle:           "GSPAY2 API error",
+		MsgConfigRequired:            "is required",
+		MsgConfigTimeoutTooShort:     "must be at least %s",
+		MsgConfigNegative:            "must not be negative",
+		MsgConfigNotPositive:         "must be greater than zero",
+		MsgConfigRetryWaitOrder:      "must not be less than retry_wait_min",
+		MsgConfigInvalidURL:          "must be an absolute http or https URL",
+		MsgConfigInvalidIP:           "%q is not an IP address or CIDR range",
+		MsgConfigUnsupportedLanguage: "unsupported language %q",
+		MsgConfigInvalidValue:        "invalid value %q",
 
 		// Request retry messages
 		MsgRequestFailedAfterRetries: "request failed after %d retries",
@@ -151,6 +274,7 @@ var translations = map[Language]map[MessageKey]string{
 		LogIDRSigVerifyFailedFormat:   "IDR signature verification failed: invalid amount format",
 		LogIDRSigVerifyFailedMismatch: "IDR signature verification failed: signature mismatch",
 		LogIDRCallbackIPFailed:        "IDR callback IP verification failed",
+		LogIDRChannelDropped:          "unsupported payment channel dropped, the user will choose on the payment page",
 
 		// Log messages - USDT Payment
 		LogCreatingUSDTPayment:         "creating USDT payment",
@@ -165,22 +289,34 @@ var translations = map[Language]map[MessageKey]string{
 		LogUSDTCallbackIPFailed:        "USDT callback IP verification failed",
 
 		// Log messages - IDR Payout
-		LogCreatingIDRPayout:          "creating IDR payout",
-		LogIDRPayoutCreated:           "IDR payout created",
-		LogQueryingIDRPayoutStatus:    "querying IDR payout status",
-		LogIDRPayoutStatusRetrieved:   "IDR payout status retrieved",
-		LogVerifyingIDRPayoutSig:      "verifying IDR payout signature",
-		LogIDRPayoutSigVerified:       "IDR payout signature verified",
-		LogVerifyingIDRPayoutCallback: "verifying IDR payout callback",
-		LogIDRPayoutCallbackVerified:  "IDR payout callback verified",
-		LogIDRPayoutSigFailedMissing:  "IDR payout signature verification failed: missing field",
-		LogIDRPayoutSigFailedFormat:   "IDR payout signature verification failed: invalid amount format",
-		LogIDRPayoutSigFailedMismatch: "IDR payout signature verification failed: signature mismatch",
-		LogIDRPayoutCallbackIPFailed:  "IDR payout callback IP verification failed",
+		LogCreatingIDRPayout:           "creating IDR payout",
+		LogIDRPayoutCreated:            "IDR payout created",
+		LogPayoutInsufficientBalance:   "insufficient balance for IDR payout",
+		LogPayoutBalanceUnavailable:    "balance not yet available, skipping pre-flight check",
+		LogQueryingIDRPayoutStatus:     "querying IDR payout status",
+		LogIDRPayoutStatusRetrieved:    "IDR payout status retrieved",
+		LogVerifyingIDRPayoutSig:       "verifying IDR payout signature",
+		LogIDRPayoutSigVerified:        "IDR payout signature verified",
+		LogVerifyingIDRPayoutStatusSig: "verifying IDR payout status signature",
+		LogIDRPayoutStatusSigVerified:  "IDR payout status signature verified",
+		LogVerifyingIDRPayoutCallback:  "verifying IDR payout callback",
+		LogIDRPayoutCallbackVerified:   "IDR payout callback verified",
+		LogIDRPayoutSigFailedMissing:   "IDR payout signature verification failed: missing field",
+		LogIDRPayoutSigFailedFormat:    "IDR payout signature verification failed: invalid amount format",
+		LogIDRPayoutSigFailedMismatch:  "IDR payout signature verification failed: signature mismatch",
+		LogIDRPayoutCallbackIPFailed:   "IDR payout callback IP verification failed",
 
 		// Log messages - Balance
-		LogQueryingBalance:  "querying operator balance",
-		LogBalanceRetrieved: "balance retrieved",
+		LogQueryingBalance:   "querying operator balance",
+		LogBalanceRetrieved:  "balance retrieved",
+		LogBalanceLow:        "balance below low-water mark",
+		LogBalanceRecovered:  "balance recovered above threshold",
+		LogBalancePollFailed: "balance poll failed",
+
+		// Log messages - Signature
+		LogSecretKeyMatched:         "signature matched secret key",
+		LogPreviousSecretKeyMatched: "signature matched a previous secret key; finish rotating it out",
+		LogCredentialsUnavailable:   "could not resolve credentials for signature verification",
 
 		// Log messages - HTTP Request
 		LogHTTPErrorResponse:   "HTTP error response",
@@ -197,28 +333,83 @@ var translations = map[Language]map[MessageKey]string{
 	},
 	Indonesian: {
 		// Sentinel errors
-		MsgInvalidTransactionID: "ID transaksi harus 5-20 karakter",
-		MsgInvalidAmount:        "jumlah pembayaran tidak valid",
-		MsgInvalidBankCode:      "kode bank tidak valid",
-		MsgInvalidSignature:     "tanda tangan tidak valid",
-		MsgMissingCallbackField: "field callback yang diperlukan tidak ada",
-		MsgEmptyResponse:        "respons kosong dari API",
-		MsgInvalidJSON:          "respons JSON tidak valid",
-		MsgRequestFailed:        "permintaan gagal",
-		MsgIPNotWhitelisted:     "alamat IP tidak ada dalam whitelist",
-		MsgInvalidIPAddress:     "format alamat IP tidak valid",
-		MsgRateLimited:          "dibatasi oleh API",
-		MsgEmptyQRContent:       "konten kode QR tidak boleh kosong",
-		MsgQREncodeFailed:       "gagal mengenkode kode QR",
+		MsgInvalidTransactionID:   "ID transaksi harus 5-20 karakter",
+		MsgInvalidAmount:          "jumlah pembayaran tidak valid",
+		MsgInvalidBankCode:        "kode bank tidak valid",
+		MsgInvalidSignature:       "tanda tangan tidak valid",
+		MsgMissingCallbackField:   "field callback yang diperlukan tidak ada",
+		MsgEmptyResponse:          "respons kosong dari API",
+		MsgInvalidJSON:            "respons JSON tidak valid",
+		MsgRequestFailed:          "permintaan gagal",
+		MsgIPNotWhitelisted:       "alamat IP tidak ada dalam whitelist",
+		MsgInvalidIPAddress:       "format alamat IP tidak valid",
+		MsgRateLimited:            "dibatasi oleh API",
+		MsgEmptyQRContent:         "konten kode QR tidak boleh kosong",
+		MsgQREncodeFailed:         "gagal mengenkode kode QR",
+		MsgRecordNotFound:         "catatan ledger tidak ditemukan",
+		MsgUnsupportedKind:        "jenis transaksi tidak didukung",
+		MsgInsufficientBalance:    "saldo tidak mencukupi",
+		MsgUnknownMerchant:        "merchant tidak dikenal",
+		MsgDuplicateMerchant:      "merchant sudah terdaftar",
+		MsgInvalidMerchantConfig:  "konfigurasi merchant tidak valid",
+		MsgNoMatchingMerchant:     "tidak ada merchant yang cocok dengan callback",
+		MsgCredentialsUnavailable: "kredensial tidak tersedia",
+		MsgInvalidConfig:          "konfigurasi client tidak valid",
+		MsgResponseTooLarge:       "body respons melebihi ukuran maksimum",
+		MsgDuplicateTransaction:   "ID transaksi duplikat",
+		MsgBankMaintenance:        "bank sedang dalam pemeliharaan",
+		MsgAccountMismatch:        "nama rekening tidak sesuai dengan nomor rekening",
+
+		// Business error explanations
+		MsgExplainDuplicateTransaction: "ID transaksi sudah pernah digunakan. Periksa status transaksi yang ada alih-alih membuatnya lagi, atau gunakan ID transaksi baru.",
+		MsgExplainInvalidSignature:     "GSPAY2 tidak dapat memverifikasi tanda tangan permintaan. Pastikan kunci rahasia sesuai dengan akun operator dan format jumlah sudah benar.",
+		MsgExplainInsufficientBalance:  "Saldo settlement operator tidak mencukupi untuk penarikan. Isi ulang saldo lalu coba lagi.",
+		MsgExplainBankMaintenance:      "Bank atau kanal tujuan sedang dalam pemeliharaan sementara. Coba lagi nanti atau tawarkan bank atau kanal lain.",
+		MsgExplainAccountMismatch:      "Nama pemilik rekening tidak sesuai dengan nomor rekening di bank. Minta pengguna memeriksa detail rekeningnya.",
+		MsgInvalidUsername:             "nama pengguna wajib diisi",
+		MsgInvalidAccountName:          "nama rekening wajib diisi",
+		MsgInvalidAccountNumber:        "nomor rekening hanya boleh berisi angka",
+		MsgInvalidDescription:          "deskripsi terlalu panjang",
+		MsgInvalidChannel:              "kanal pembayaran tidak didukung",
+		MsgInvalidExpireDate:           "format tanggal kedaluwarsa tidak dikenali",
+		MsgIllegalTransition:           "transisi status tidak diizinkan",
+		MsgEventMismatch:               "event milik transaksi lain",
+		MsgUnknownCallback:             "payload callback tidak dikenali",
+		MsgStaleTimestamp:              "timestamp di luar toleransi yang diizinkan",
+		MsgCallbackTooLarge:            "body callback melebihi ukuran maksimum",
+		MsgDuplicateKey:                "kunci JSON duplikat",
+		MsgDeliveryFailed:              "pengiriman ditolak oleh target",
 
 		// Validation errors
-		MsgMinAmountIDR:          "jumlah minimum adalah 10000 IDR",
-		MsgMinAmountUSDT:         "jumlah minimum adalah 1.00 USDT",
-		MsgMinPayoutAmountIDR:    "jumlah pembayaran minimum adalah 10000 IDR",
-		MsgInvalidAmountFormat:   "format jumlah tidak valid",
-		MsgValidationErrorFormat: "gspay: kesalahan validasi untuk %s: %s",
-		MsgAPIErrorFormat:        "gspay: kesalahan API %d pada %s: %s",
-		MsgAPIErrorFormatNoURL:   "gspay: kesalahan API %d: %s",
+		MsgMinAmountIDR:              "jumlah minimum adalah 10000 IDR",
+		MsgMinAmountUSDT:             "jumlah minimum adalah 1.00 USDT",
+		MsgMinPayoutAmountIDR:        "jumlah pembayaran minimum adalah 10000 IDR",
+		MsgInvalidAmountFormat:       "format jumlah tidak valid",
+		MsgTransactionIDLength:       "harus terdiri dari %d hingga %d karakter",
+		MsgTransactionIDChars:        "hanya boleh berisi huruf, angka, '-' dan '_'",
+		MsgDescriptionTooLong:        "maksimal %d karakter",
+		MsgAccountNumberDigits:       "nomor rekening untuk %s hanya boleh berisi angka",
+		MsgAccountNumberLength:       "nomor rekening untuk %s harus %d digit",
+		MsgAccountNumberRange:        "nomor rekening untuk %s harus %d hingga %d digit",
+		MsgAccountNumberPhone:        "nomor rekening untuk %s harus berupa nomor ponsel yang diawali %s",
+		MsgChannelAmountRange:        "harus antara %d dan %d untuk %s",
+		MsgChannelMinAmount:          "minimal %d untuk %s",
+		MsgValidationErrorFormat:     "gspay: kesalahan validasi untuk %s: %s",
+		MsgValidationSubject:         "%s: %s",
+		MsgAmountExceedsBalance:      "saldo tidak mencukupi: %d > %.2f",
+		MsgAPIErrorFormat:            "gspay: kesalahan API %d pada %s: %s",
+		MsgAPIErrorFormatNoURL:       "gspay: kesalahan API %d: %s",
+		MsgProblemValidationTitle:    "validasi permintaan gagal",
+		MsgProblemAPITitle:           "kesalahan API GSPAY2",
+		MsgConfigRequired:            "wajib diisi",
+		MsgConfigTimeoutTooShort:     "minimal %s",
+		MsgConfigNegative:            "tidak boleh negatif",
+		MsgConfigNotPositive:         "harus lebih besar dari nol",
+		MsgConfigRetryWaitOrder:      "tidak boleh lebih kecil dari retry_wait_min",
+		MsgConfigInvalidURL:          "harus berupa URL http atau https absolut",
+		MsgConfigInvalidIP:           "%q bukan alamat IP atau rentang CIDR",
+		MsgConfigUnsupportedLanguage: "bahasa %q tidak didukung",
+		MsgConfigInvalidValue:        "nilai %q tidak valid",
 
 		// Request retry messages
 		MsgRequestFailedAfterRetries: "permintaan gagal setelah %d percobaan",
@@ -238,6 +429,7 @@ var translations = map[Language]map[MessageKey]string{
 		LogIDRSigVerifyFailedFormat:   "verifikasi tanda tangan IDR gagal: format jumlah tidak valid",
 		LogIDRSigVerifyFailedMismatch: "verifikasi tanda tangan IDR gagal: tanda tangan tidak cocok",
 		LogIDRCallbackIPFailed:        "verifikasi IP callback IDR gagal",
+		LogIDRChannelDropped:          "kanal pembayaran tidak didukung diabaikan, pengguna akan memilih di halaman pembayaran",
 
 		// Log messages - USDT Payment
 		LogCreatingUSDTPayment:         "membuat pembayaran USDT",
@@ -252,22 +444,34 @@ var translations = map[Language]map[MessageKey]string{
 		LogUSDTCallbackIPFailed:        "verifikasi IP callback USDT gagal",
 
 		// Log messages - IDR Payout
-		LogCreatingIDRPayout:          "membuat penarikan IDR",
-		LogIDRPayoutCreated:           "penarikan IDR berhasil dibuat",
-		LogQueryingIDRPayoutStatus:    "mengambil status penarikan IDR",
-		LogIDRPayoutStatusRetrieved:   "status penarikan IDR berhasil diambil",
-		LogVerifyingIDRPayoutSig:      "memverifikasi tanda tangan penarikan IDR",
-		LogIDRPayoutSigVerified:       "tanda tangan penarikan IDR terverifikasi",
-		LogVerifyingIDRPayoutCallback: "memverifikasi callback penarikan IDR",
-		LogIDRPayoutCallbackVerified:  "callback penarikan IDR terverifikasi",
-		LogIDRPayoutSigFailedMissing:  "verifikasi tanda tangan penarikan IDR gagal: field tidak ada",
-		LogIDRPayoutSigFailedFormat:   "verifikasi tanda tangan penarikan IDR gagal: format jumlah tidak valid",
-		LogIDRPayoutSigFailedMismatch: "verifikasi tanda tangan penarikan IDR gagal: tanda tangan tidak cocok",
-		LogIDRPayoutCallbackIPFailed:  "verifikasi IP callback penarikan IDR gagal",
+		LogCreatingIDRPayout:           "membuat penarikan IDR",
+		LogIDRPayoutCreated:            "penarikan IDR berhasil dibuat",
+		LogPayoutInsufficientBalance:   "saldo tidak mencukupi untuk penarikan IDR",
+		LogPayoutBalanceUnavailable:    "saldo belum tersedia, pemeriksaan awal dilewati",
+		LogQueryingIDRPayoutStatus:     "mengambil status penarikan IDR",
+		LogIDRPayoutStatusRetrieved:    "status penarikan IDR berhasil diambil",
+		LogVerifyingIDRPayoutSig:       "memverifikasi tanda tangan penarikan IDR",
+		LogIDRPayoutSigVerified:        "tanda tangan penarikan IDR terverifikasi",
+		LogVerifyingIDRPayoutStatusSig: "memverifikasi tanda tangan status penarikan IDR",
+		LogIDRPayoutStatusSigVerified:  "tanda tangan status penarikan IDR terverifikasi",
+		LogVerifyingIDRPayoutCallback:  "memverifikasi callback penarikan IDR",
+		LogIDRPayoutCallbackVerified:   "callback penarikan IDR terverifikasi",
+		LogIDRPayoutSigFailedMissing:   "verifikasi tanda tangan penarikan IDR gagal: field tidak ada",
+		LogIDRPayoutSigFailedFormat:    "verifikasi tanda tangan penarikan IDR gagal: format jumlah tidak valid",
+		LogIDRPayoutSigFailedMismatch:  "verifikasi tanda tangan penarikan IDR gagal: tanda tangan tidak cocok",
+		LogIDRPayoutCallbackIPFailed:   "verifikasi IP callback penarikan IDR gagal",
 
 		// Log messages - Balance
-		LogQueryingBalance:  "mengambil saldo operator",
-		LogBalanceRetrieved: "saldo berhasil diambil",
+		LogQueryingBalance:   "mengambil saldo operator",
+		LogBalanceRetrieved:  "saldo berhasil diambil",
+		LogBalanceLow:        "saldo di bawah batas minimum",
+		LogBalanceRecovered:  "saldo kembali di atas ambang batas",
+		LogBalancePollFailed: "gagal memeriksa saldo",
+
+		// Log messages - Signature
+		LogSecretKeyMatched:         "tanda tangan cocok dengan kunci rahasia",
+		LogPreviousSecretKeyMatched: "tanda tangan cocok dengan kunci rahasia sebelumnya; selesaikan rotasinya",
+		LogCredentialsUnavailable:   "gagal memperoleh kredensial untuk verifikasi tanda tangan",
 
 		// Log messages - HTTP Request
 		LogHTTPErrorResponse:   "respons error HTTP",
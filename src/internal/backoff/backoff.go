@@ -0,0 +1,45 @@
+// Copyright 2026 H0llyW00dzZ
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backoff
+
+import (
+	"math/rand/v2"
+	"time"
+)
+
+// Exponential returns the wait before the next attempt after attempts
+// failures: minWait after the first failure, doubling, plus up to 25%
+// jitter, and never more than maxWait. The doubling stops once maxWait is
+// reached, so any number of attempts is safe.
+func Exponential(minWait, maxWait time.Duration, attempts int) time.Duration {
+	wait := minWait
+	for range attempts - 1 {
+		// Stop doubling before it can overflow.
+		if wait >= maxWait/2 {
+			wait = maxWait
+			break
+		}
+		wait *= 2
+	}
+	wait = min(wait, maxWait)
+	if jitterMax := int64(wait / 4); jitterMax > 0 {
+		jitter := time.Duration(rand.Int64N(jitterMax))
+		if jitter > maxWait-wait {
+			return maxWait
+		}
+		wait += jitter
+	}
+	return wait
+}
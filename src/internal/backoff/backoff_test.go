@@ -0,0 +1,50 @@
+// Copyright 2026 H0llyW00dzZ
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backoff
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExponential(t *testing.T) {
+	t.Run("doubles with jitter up to max", func(t *testing.T) {
+		for attempts, base := range map[int]time.Duration{
+			0:   time.Second,
+			1:   time.Second,
+			2:   2 * time.Second,
+			4:   8 * time.Second,
+			5:   10 * time.Second,
+			100: 10 * time.Second,
+		} {
+			wait := Exponential(time.Second, 10*time.Second, attempts)
+			assert.GreaterOrEqual(t, wait, base, attempts)
+			assert.LessOrEqual(t, wait, min(base+base/4, 10*time.Second), attempts)
+		}
+	})
+
+	t.Run("does not overflow", func(t *testing.T) {
+		for attempts := 1; attempts <= 40; attempts++ {
+			wait := Exponential(time.Minute, time.Hour, attempts)
+			assert.GreaterOrEqual(t, wait, time.Minute, attempts)
+			assert.LessOrEqual(t, wait, time.Hour, attempts)
+		}
+		assert.Equal(t, time.Hour, Exponential(time.Minute, time.Hour, math.MaxInt))
+		assert.Equal(t, time.Duration(math.MaxInt64), Exponential(time.Nanosecond, math.MaxInt64, 200))
+	})
+}
@@ -0,0 +1,22 @@
+// Copyright 2026 H0llyW00dzZ
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package backoff computes the exponential retry waits shared by the webhook
+// router workers and the event forwarder.
+//
+// [Exponential] starts at a minimum wait, doubles it after every failure up
+// to a maximum, and adds jitter so that retries of many jobs do not line up:
+//
+//	wait := backoff.Exponential(time.Second, time.Minute, job.Attempts)
+package backoff
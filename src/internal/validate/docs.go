@@ -0,0 +1,25 @@
+// Copyright 2026 H0llyW00dzZ
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package validate provides the request field checks shared by the payment
+// and payout services.
+//
+// Each check appends its failures to an [errors.ValidationErrors], so a
+// request's Validate method can report every failing field at once:
+//
+//	var errs errors.ValidationErrors
+//	validate.TransactionID(&errs, lang, req.TransactionID)
+//	validate.Username(&errs, lang, req.Username)
+//	return errs
+package validate
@@ -0,0 +1,103 @@
+// Copyright 2026 H0llyW00dzZ
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validate
+
+import (
+	"strings"
+	"unicode/utf8"
+
+	"github.com/H0llyW00dzZ/gspay-go-sdk/src/constants"
+	"github.com/H0llyW00dzZ/gspay-go-sdk/src/errors"
+	"github.com/H0llyW00dzZ/gspay-go-sdk/src/i18n"
+)
+
+// TransactionID checks the length and characters of a transaction ID.
+//
+// Only letters, digits, '-' and '_' are accepted, since the ID is also sent
+// as a URL query parameter when querying status.
+func TransactionID(errs *errors.ValidationErrors, lang i18n.Language, id string) {
+	if len(id) < constants.MinTransactionIDLength || len(id) > constants.MaxTransactionIDLength {
+		errs.AddKey(lang, "transaction_id", errors.ErrInvalidTransactionID,
+			errors.KeyTransactionIDLength, constants.MinTransactionIDLength, constants.MaxTransactionIDLength)
+		return
+	}
+	for _, r := range id {
+		if !isTransactionIDChar(r) {
+			errs.AddKey(lang, "transaction_id", errors.ErrInvalidTransactionID, errors.KeyTransactionIDChars)
+			return
+		}
+	}
+}
+
+// Username checks that a username is present.
+func Username(errs *errors.ValidationErrors, lang i18n.Language, username string) {
+	if strings.TrimSpace(username) == "" {
+		errs.AddKey(lang, "username", errors.ErrInvalidUsername, errors.MsgInvalidUsername)
+	}
+}
+
+// AccountName checks that a payout account name is present.
+func AccountName(errs *errors.ValidationErrors, lang i18n.Language, name string) {
+	if strings.TrimSpace(name) == "" {
+		errs.AddKey(lang, "account_name", errors.ErrInvalidAccountName, errors.MsgInvalidAccountName)
+	}
+}
+
+// AccountNumber checks a payout account number against the rule of its bank
+// (see [constants.GetAccountRule]).
+func AccountNumber(errs *errors.ValidationErrors, lang i18n.Language, currency constants.Currency, bankCode, number string) {
+	rule := constants.GetAccountRule(bankCode, currency)
+	reason := rule.Check(number)
+	if reason == 0 {
+		return
+	}
+	accErr := &constants.AccountNumberError{BankCode: strings.ToUpper(bankCode), Rule: rule, Reason: reason}
+	key, args := accErr.Message()
+	errs.AddKey(lang, "account_number", errors.ErrInvalidAccountNumber, key, args...)
+}
+
+// Description checks that an optional description is not too long.
+func Description(errs *errors.ValidationErrors, lang i18n.Language, description string) {
+	if utf8.RuneCountInString(description) > constants.MaxDescriptionLength {
+		errs.AddKey(lang, "description", errors.ErrInvalidDescription, errors.KeyDescriptionTooLong, constants.MaxDescriptionLength)
+	}
+}
+
+// Channel checks that an IDR payment channel is registered and that amount
+// is within its limits (see [constants.LookupChannel]).
+func Channel(errs *errors.ValidationErrors, lang i18n.Language, channel constants.ChannelIDR, amount int64) {
+	ch, ok := constants.LookupChannel(channel)
+	if !ok {
+		errs.AddKey(lang, "channel", errors.ErrInvalidChannel,
+			i18n.MsgValidationSubject, strings.ToUpper(string(channel)), errors.MsgInvalidChannel)
+		return
+	}
+	if amount < constants.MinAmountIDR {
+		// Already reported against the IDR minimum.
+		return
+	}
+	minAmount, maxAmount := ch.AmountRange()
+	switch {
+	case maxAmount > 0 && (amount < minAmount || amount > maxAmount):
+		errs.AddKey(lang, "amount", errors.ErrInvalidAmount, errors.KeyChannelAmountRange, minAmount, maxAmount, ch.Code)
+	case amount < minAmount:
+		errs.AddKey(lang, "amount", errors.ErrInvalidAmount, errors.KeyChannelMinAmount, minAmount, ch.Code)
+	}
+}
+
+// isTransactionIDChar reports whether r may appear in a transaction ID.
+func isTransactionIDChar(r rune) bool {
+	return r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || r == '-' || r == '_'
+}
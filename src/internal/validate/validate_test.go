@@ -0,0 +1,167 @@
+// Copyright 2026 H0llyW00dzZ
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validate
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/H0llyW00dzZ/gspay-go-sdk/src/constants"
+	"github.com/H0llyW00dzZ/gspay-go-sdk/src/errors"
+	"github.com/H0llyW00dzZ/gspay-go-sdk/src/i18n"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTransactionID(t *testing.T) {
+	tests := []struct {
+		name  string
+		id    string
+		valid bool
+		msg   i18n.MessageKey
+	}{
+		{"letters and digits", "TXN123456789", true, ""},
+		{"dash and underscore", "order-2026_01", true, ""},
+		{"minimum length", "TXN12", true, ""},
+		{"maximum length", strings.Repeat("a", constants.MaxTransactionIDLength), true, ""},
+		{"too short", "TXN", false, errors.KeyTransactionIDLength},
+		{"too long", strings.Repeat("a", constants.MaxTransactionIDLength+1), false, errors.KeyTransactionIDLength},
+		{"space", "TXN 12345", false, errors.KeyTransactionIDChars},
+		{"url characters", "TXN?a=1&b", false, errors.KeyTransactionIDChars},
+		{"non-ascii", "TXNé12345", false, errors.KeyTransactionIDChars},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var errs errors.ValidationErrors
+			TransactionID(&errs, i18n.English, tt.id)
+			if tt.valid {
+				assert.Empty(t, errs)
+				return
+			}
+			require.Len(t, errs, 1)
+			assert.Equal(t, "transaction_id", errs[0].Field)
+			assert.ErrorIs(t, errs, errors.ErrInvalidTransactionID)
+			if tt.msg == errors.KeyTransactionIDChars {
+				assert.Equal(t, i18n.Get(i18n.English, tt.msg), errs[0].Message)
+			} else {
+				assert.Equal(t, "must be between 5 and 20 characters", errs[0].Message)
+			}
+		})
+	}
+}
+
+func TestAccountNumber(t *testing.T) {
+	tests := []struct {
+		name     string
+		currency constants.Currency
+		bank     string
+		number   string
+		want     string
+	}{
+		{"valid BCA", constants.CurrencyIDR, "BCA", "1234567890", ""},
+		{"valid DANA", constants.CurrencyIDR, "dana", "081234567890", ""},
+		{"valid TNG", constants.CurrencyMYR, "TNG", "0123456789", ""},
+		{"empty", constants.CurrencyIDR, "BCA", "", "account number for BCA must contain only digits"},
+		{"separators", constants.CurrencyIDR, "BCA", "123-456-7890", "account number for BCA must contain only digits"},
+		{"non-ascii digits", constants.CurrencyIDR, "BCA", "١٢٣٤٥٦٧٨٩٠", "account number for BCA must contain only digits"},
+		{"fixed length", constants.CurrencyIDR, "bca", "123456789", "account number for BCA must be 10 digits"},
+		{"length range", constants.CurrencyIDR, "CIMB", "123", "account number for CIMB must be 13 to 14 digits"},
+		{"not a phone number", constants.CurrencyIDR, "OVO", "1234567890", "account number for OVO must be a mobile number starting with 08/628"},
+		{"unknown bank uses default", constants.CurrencyIDR, "XYZ", "1234", "account number for XYZ must be 5 to 20 digits"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var errs errors.ValidationErrors
+			AccountNumber(&errs, i18n.English, tt.currency, tt.bank, tt.number)
+			if tt.want == "" {
+				assert.Empty(t, errs)
+				return
+			}
+			require.Len(t, errs, 1)
+			assert.Equal(t, "account_number", errs[0].Field)
+			assert.Equal(t, tt.want, errs[0].Message)
+			assert.ErrorIs(t, errs, errors.ErrInvalidAccountNumber)
+		})
+	}
+
+	t.Run("localized", func(t *testing.T) {
+		var errs errors.ValidationErrors
+		AccountNumber(&errs, i18n.Indonesian, constants.CurrencyIDR, "BCA", "12345")
+		require.Len(t, errs, 1)
+		assert.Equal(t, "nomor rekening untuk BCA harus 10 digit", errs[0].Message)
+	})
+}
+
+func TestDescription(t *testing.T) {
+	var errs errors.ValidationErrors
+	Description(&errs, i18n.English, strings.Repeat("é", constants.MaxDescriptionLength))
+	assert.Empty(t, errs, "length is counted in characters, not bytes")
+
+	Description(&errs, i18n.Indonesian, strings.Repeat("x", constants.MaxDescriptionLength+1))
+	require.Len(t, errs, 1)
+	assert.Equal(t, "maksimal 255 karakter", errs[0].Message)
+}
+
+func TestUsernameAndAccountName(t *testing.T) {
+	var errs errors.ValidationErrors
+	Username(&errs, i18n.English, "user123")
+	AccountName(&errs, i18n.English, "John Doe")
+	assert.Empty(t, errs)
+
+	Username(&errs, i18n.English, "")
+	AccountName(&errs, i18n.English, "   ")
+	require.Len(t, errs, 2)
+	assert.ErrorIs(t, errs, errors.ErrInvalidUsername)
+	assert.ErrorIs(t, errs, errors.ErrInvalidAccountName)
+}
+
+func TestChannel(t *testing.T) {
+	tests := []struct {
+		name    string
+		channel constants.ChannelIDR
+		amount  int64
+		field   string
+		msg     string
+	}{
+		{"valid", constants.ChannelQRIS, 50000, "", ""},
+		{"lowercase", "dana", 50000, "", ""},
+		{"unknown", "gopay", 50000, "channel", "GOPAY: unsupported payment channel"},
+		{"above maximum", constants.ChannelQRIS, 10_000_001, "amount", "must be between 10000 and 10000000 for QRIS"},
+		{"below IDR minimum is left to Validate", constants.ChannelQRIS, 100, "", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var errs errors.ValidationErrors
+			Channel(&errs, i18n.English, tt.channel, tt.amount)
+			if tt.field == "" {
+				assert.Empty(t, errs)
+				return
+			}
+			require.Len(t, errs, 1)
+			assert.Equal(t, tt.field, errs[0].Field)
+			assert.Equal(t, tt.msg, errs[0].Message)
+		})
+	}
+
+	t.Run("channel minimum", func(t *testing.T) {
+		constants.RegisterChannel(constants.Channel{Code: "TEST", MinAmount: 20000})
+		t.Cleanup(func() { constants.UnregisterChannel("TEST") })
+
+		var errs errors.ValidationErrors
+		Channel(&errs, i18n.Indonesian, "TEST", 15000)
+		require.Len(t, errs, 1)
+		assert.Equal(t, "minimal 20000 untuk TEST", errs[0].Message)
+	})
+}
@@ -0,0 +1,61 @@
+// Copyright 2026 H0llyW00dzZ
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package ledger provides a persistent transaction ledger for the GSPAY2 SDK.
+//
+// The SDK services are stateless: once a call returns, nothing is remembered.
+// The ledger records every create request, create response, status poll, and
+// verified callback so that transactions can be reconciled later.
+//
+// # Basic Usage
+//
+//	store, err := ledger.OpenFileStore("gspay-ledger.json")
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	l := ledger.New(store)
+//
+//	req := &payment.IDRRequest{TransactionID: "TXN123456789", Username: "user123", Amount: 50000}
+//	resp, err := paymentSvc.Create(ctx, req)
+//	_ = l.RecordIDRPayment(ctx, req, resp, err)
+//
+//	// Later, after the callback has been verified
+//	_ = l.RecordIDRPaymentCallback(ctx, &callback)
+//
+// # Storage
+//
+// Records are persisted through the [Store] interface. Built-in implementations:
+//   - [MemoryStore]: In-memory storage for tests and short-lived processes
+//   - [FileStore]: Embedded JSON file storage with atomic writes
+//
+// Custom stores (SQL, key-value, etc.) only need to implement [Store].
+//
+// # Queries
+//
+// Records are keyed by transaction ID and can be looked up by the GSPAY2 ID
+// with [Ledger.FindByProviderID]. Common reconciliation queries are built in:
+//
+//	// Pending for more than 30 minutes
+//	stale, err := l.PendingLongerThan(ctx, 30*time.Minute)
+//
+//	// Succeeded according to a status poll, but no callback received
+//	missing, err := l.SucceededWithoutCallback(ctx)
+//
+// Arbitrary queries can be expressed with [Filter] values:
+//
+//	payouts, err := l.List(ctx, ledger.All(
+//	    ledger.ByKind(ledger.KindPayoutIDR),
+//	    ledger.SucceededWithoutCallback(),
+//	))
+package ledger
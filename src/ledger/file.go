@@ -0,0 +1,157 @@
+// Copyright 2026 H0llyW00dzZ
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ledger
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// FileStore is an embedded [Store] backed by a single JSON file.
+//
+// All records are kept in memory and the whole file is rewritten after every
+// update. Writes go to a temporary file that is synced and atomically renamed
+// over the target, so a crash never leaves a partially written ledger.
+//
+// FileStore is intended for single-process deployments with a moderate number
+// of records. Only one process should open a given file at a time.
+type FileStore struct {
+	mu   sync.RWMutex
+	path string
+	recs records
+}
+
+// OpenFileStore opens the JSON ledger file at path, creating it on first write.
+//
+// Example:
+//
+//	store, err := ledger.OpenFileStore("/var/lib/myapp/gspay-ledger.json")
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	l := ledger.New(store)
+func OpenFileStore(path string) (*FileStore, error) {
+	s := &FileStore{path: path, recs: newRecords()}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return s, nil
+		}
+		return nil, err
+	}
+	if len(data) == 0 {
+		return s, nil
+	}
+
+	var list []*Record
+	if err := json.Unmarshal(data, &list); err != nil {
+		return nil, err
+	}
+	for _, rec := range list {
+		s.recs.byTxID[rec.TransactionID] = rec
+	}
+	return s, nil
+}
+
+// Path returns the path of the ledger file.
+func (s *FileStore) Path() string { return s.path }
+
+// Update implements [Store.Update].
+//
+// If the file cannot be written, the in-memory state is rolled back and
+// the write error is returned.
+func (s *FileStore) Update(ctx context.Context, transactionID string, fn func(rec *Record) error) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	prev, existed := s.recs.byTxID[transactionID]
+	if _, err := s.recs.update(transactionID, fn); err != nil {
+		return err
+	}
+	if err := s.flush(); err != nil {
+		if existed {
+			s.recs.byTxID[transactionID] = prev
+		} else {
+			delete(s.recs.byTxID, transactionID)
+		}
+		return err
+	}
+	return nil
+}
+
+// Get implements [Store.Get].
+func (s *FileStore) Get(ctx context.Context, transactionID string) (*Record, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.recs.get(transactionID)
+}
+
+// FindByProviderID implements [Store.FindByProviderID].
+func (s *FileStore) FindByProviderID(ctx context.Context, kind Kind, providerID string) (*Record, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.recs.findByProviderID(kind, providerID)
+}
+
+// List implements [Store.List].
+func (s *FileStore) List(ctx context.Context, filter Filter) ([]*Record, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.recs.list(filter), nil
+}
+
+// flush writes all records to disk atomically. The caller must hold s.mu.
+func (s *FileStore) flush() error {
+	data, err := json.MarshalIndent(s.recs.list(nil), "", "  ")
+	if err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(s.path), filepath.Base(s.path)+".tmp-*")
+	if err != nil {
+		return err
+	}
+	tmpName := tmp.Name()
+	defer os.Remove(tmpName) // no-op after a successful rename
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmpName, s.path)
+}
@@ -0,0 +1,287 @@
+// Copyright 2026 H0llyW00dzZ
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ledger
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"time"
+
+	"github.com/H0llyW00dzZ/gspay-go-sdk/src/constants"
+	"github.com/H0llyW00dzZ/gspay-go-sdk/src/errors"
+	"github.com/H0llyW00dzZ/gspay-go-sdk/src/i18n"
+	"github.com/H0llyW00dzZ/gspay-go-sdk/src/payment"
+	"github.com/H0llyW00dzZ/gspay-go-sdk/src/payout"
+)
+
+// Ledger records the lifecycle of GSPAY2 transactions in a [Store].
+//
+// Every create request, create response, status poll, and verified callback
+// is appended to the transaction's history, and the aggregated [Record] is
+// kept up to date so it can be queried later.
+type Ledger struct {
+	store Store
+	lang  i18n.Language
+	now   func() time.Time
+}
+
+// Option is a functional option for configuring the [Ledger].
+type Option func(*Ledger)
+
+// WithLanguage sets the language for localized ledger errors.
+// Default is [i18n.English].
+func WithLanguage(lang i18n.Language) Option {
+	return func(l *Ledger) {
+		if lang.IsValid() {
+			l.lang = lang
+		}
+	}
+}
+
+// WithClock sets the function used to timestamp entries.
+// Default is [time.Now]. This is mainly useful for tests.
+func WithClock(now func() time.Time) Option {
+	return func(l *Ledger) {
+		if now != nil {
+			l.now = now
+		}
+	}
+}
+
+// New creates a new [Ledger] backed by store.
+//
+// Example:
+//
+//	l := ledger.New(ledger.NewMemoryStore())
+//
+//	resp, err := paymentSvc.Create(ctx, req)
+//	if lerr := l.RecordIDRPayment(ctx, req, resp, err); lerr != nil {
+//	    log.Printf("ledger: %v", lerr)
+//	}
+func New(store Store, opts ...Option) *Ledger {
+	l := &Ledger{
+		store: store,
+		lang:  i18n.English,
+		now:   time.Now,
+	}
+	for _, opt := range opts {
+		opt(l)
+	}
+	return l
+}
+
+// Store returns the underlying [Store].
+func (l *Ledger) Store() Store { return l.store }
+
+// RecordIDRPayment records an IDR payment create request and its outcome.
+//
+// Pass the response and error returned by [payment.IDRService.Create].
+// If callErr is non-nil, an [EntryError] is recorded instead of a response.
+func (l *Ledger) RecordIDRPayment(ctx context.Context, req *payment.IDRRequest, resp *payment.IDRResponse, callErr error) error {
+	amount := strconv.FormatInt(req.Amount, 10)
+	return l.recordCreate(ctx, KindPaymentIDR, req.TransactionID, req.Username, amount, req, callErr, func(rec *Record, e *Entry) {
+		rec.ProviderID = resp.IDRPaymentID
+		e.Status = parseStatus(resp.Status)
+		e.Amount = resp.Amount
+		e.Data = marshal(resp)
+	}, resp != nil)
+}
+
+// RecordUSDTPayment records a USDT payment create request and its outcome.
+//
+// Pass the response and error returned by [payment.USDTService.Create].
+// If callErr is non-nil, an [EntryError] is recorded instead of a response.
+func (l *Ledger) RecordUSDTPayment(ctx context.Context, req *payment.USDTRequest, resp *payment.USDTResponse, callErr error) error {
+	amount := strconv.FormatFloat(req.Amount, 'f', 2, 64)
+	return l.recordCreate(ctx, KindPaymentUSDT, req.TransactionID, req.Username, amount, req, callErr, func(rec *Record, e *Entry) {
+		rec.ProviderID = resp.CryptoPaymentID
+		e.Status = constants.StatusPending
+		e.Data = marshal(resp)
+	}, resp != nil)
+}
+
+// RecordIDRPayout records an IDR payout create request and its outcome.
+//
+// Pass the response and error returned by [payout.IDRService.Create].
+// If callErr is non-nil, an [EntryError] is recorded instead of a response.
+func (l *Ledger) RecordIDRPayout(ctx context.Context, req *payout.IDRRequest, resp *payout.IDRResponse, callErr error) error {
+	amount := strconv.FormatInt(req.Amount, 10)
+	return l.recordCreate(ctx, KindPayoutIDR, req.TransactionID, req.Username, amount, req, callErr, func(rec *Record, e *Entry) {
+		rec.ProviderID = string(resp.IDRPayoutID)
+		e.Status = resp.Status
+		e.Data = marshal(resp)
+	}, resp != nil)
+}
+
+// RecordIDRPaymentStatus records the result of [payment.IDRService.GetStatus].
+func (l *Ledger) RecordIDRPaymentStatus(ctx context.Context, status *payment.IDRStatusResponse) error {
+	return l.recordUpdate(ctx, KindPaymentIDR, status.TransactionID, string(status.IDRPaymentID), Entry{
+		Type:   EntryStatus,
+		Status: status.Status,
+		Amount: string(status.Amount),
+		Data:   marshal(status),
+	}, status.Completed || !status.Status.IsPending())
+}
+
+// RecordIDRPayoutStatus records the result of [payout.IDRService.GetStatus].
+func (l *Ledger) RecordIDRPayoutStatus(ctx context.Context, status *payout.IDRStatusResponse) error {
+	return l.recordUpdate(ctx, KindPayoutIDR, status.TransactionID, string(status.IDRPayoutID), Entry{
+		Type:   EntryStatus,
+		Status: status.PaymentStatus(),
+		Amount: string(status.Amount),
+		Data:   marshal(status),
+	}, status.Completed)
+}
+
+// RecordIDRPaymentCallback records an IDR payment callback.
+//
+// Only record callbacks after they have been verified with
+// [payment.IDRService.VerifyCallback] or [payment.IDRService.VerifyCallbackWithIP].
+func (l *Ledger) RecordIDRPaymentCallback(ctx context.Context, callback *payment.IDRCallback) error {
+	return l.recordUpdate(ctx, KindPaymentIDR, callback.TransactionID, string(callback.IDRPaymentID), Entry{
+		Type:   EntryCallback,
+		Status: callback.Status,
+		Amount: string(callback.Amount),
+		Data:   marshal(callback),
+	}, !callback.Status.IsPending())
+}
+
+// RecordUSDTPaymentCallback records a USDT payment callback.
+//
+// Only record callbacks after they have been verified with
+// [payment.USDTService.VerifyCallback] or [payment.USDTService.VerifyCallbackWithIP].
+func (l *Ledger) RecordUSDTPaymentCallback(ctx context.Context, callback *payment.USDTCallback) error {
+	return l.recordUpdate(ctx, KindPaymentUSDT, callback.TransactionID, callback.CryptoPaymentID, Entry{
+		Type:   EntryCallback,
+		Status: callback.Status,
+		Amount: callback.Amount,
+		Data:   marshal(callback),
+	}, !callback.Status.IsPending())
+}
+
+// RecordIDRPayoutCallback records an IDR payout callback.
+//
+// Only record callbacks after they have been verified with
+// [payout.IDRService.VerifyCallback] or [payout.IDRService.VerifyCallbackWithIP].
+func (l *Ledger) RecordIDRPayoutCallback(ctx context.Context, callback *payout.IDRCallback) error {
+	return l.recordUpdate(ctx, KindPayoutIDR, callback.TransactionID, string(callback.IDRPayoutID), Entry{
+		Type:   EntryCallback,
+		Status: callback.PaymentStatus(),
+		Amount: string(callback.Amount),
+		Data:   marshal(callback),
+	}, callback.Completed)
+}
+
+// Get returns the record for transactionID.
+// Returns an error wrapping [errors.ErrRecordNotFound] if no record exists.
+func (l *Ledger) Get(ctx context.Context, transactionID string) (*Record, error) {
+	rec, err := l.store.Get(ctx, transactionID)
+	return rec, l.localize(err, transactionID)
+}
+
+// FindByProviderID returns the record of the given kind with the GSPAY2-assigned ID.
+// Returns an error wrapping [errors.ErrRecordNotFound] if no record exists.
+func (l *Ledger) FindByProviderID(ctx context.Context, kind Kind, providerID string) (*Record, error) {
+	rec, err := l.store.FindByProviderID(ctx, kind, providerID)
+	return rec, l.localize(err, providerID)
+}
+
+// List returns all records matching filter, ordered by creation time.
+func (l *Ledger) List(ctx context.Context, filter Filter) ([]*Record, error) {
+	return l.store.List(ctx, filter)
+}
+
+// PendingLongerThan returns transactions that have been pending for longer than d.
+//
+// Example:
+//
+//	// Find payments still pending after 30 minutes
+//	stale, err := l.PendingLongerThan(ctx, 30*time.Minute)
+func (l *Ledger) PendingLongerThan(ctx context.Context, d time.Duration) ([]*Record, error) {
+	return l.store.List(ctx, PendingSince(l.now().Add(-d)))
+}
+
+// SucceededWithoutCallback returns transactions that are known to be successful
+// but for which no verified callback has been recorded.
+func (l *Ledger) SucceededWithoutCallback(ctx context.Context) ([]*Record, error) {
+	return l.store.List(ctx, SucceededWithoutCallback())
+}
+
+// recordCreate records a create request followed by its response or error.
+func (l *Ledger) recordCreate(ctx context.Context, kind Kind, transactionID, username, amount string, req any, callErr error, applyResp func(rec *Record, e *Entry), hasResp bool) error {
+	return l.store.Update(ctx, transactionID, func(rec *Record) error {
+		now := l.now()
+		rec.Kind = kind
+		rec.Username = username
+		rec.Amount = amount
+		rec.append(Entry{Type: EntryRequest, At: now, Amount: amount, Data: marshal(req)})
+
+		switch {
+		case callErr != nil:
+			rec.append(Entry{Type: EntryError, At: now, Error: callErr.Error()})
+		case hasResp:
+			e := Entry{Type: EntryResponse, At: now}
+			applyResp(rec, &e)
+			rec.Status = e.Status
+			rec.append(e)
+		}
+		return nil
+	})
+}
+
+// recordUpdate records a status poll or callback entry.
+func (l *Ledger) recordUpdate(ctx context.Context, kind Kind, transactionID, providerID string, e Entry, completed bool) error {
+	return l.store.Update(ctx, transactionID, func(rec *Record) error {
+		if rec.Kind == "" {
+			rec.Kind = kind
+		}
+		if providerID != "" {
+			rec.ProviderID = providerID
+		}
+		e.At = l.now()
+		rec.Status = e.Status
+		rec.Completed = rec.Completed || completed
+		rec.append(e)
+		return nil
+	})
+}
+
+// localize wraps a not-found error with a localized message.
+func (l *Ledger) localize(err error, id string) error {
+	if errors.Is(err, errors.ErrRecordNotFound) {
+		return errors.New(l.lang, errors.ErrRecordNotFound, id)
+	}
+	return err
+}
+
+// parseStatus converts a string status from a create response.
+// Unparseable values are treated as pending.
+func parseStatus(s string) constants.PaymentStatus {
+	n, err := strconv.Atoi(s)
+	if err != nil {
+		return constants.StatusPending
+	}
+	return constants.ParsePaymentStatus(n)
+}
+
+// marshal encodes v as JSON, returning nil if encoding fails.
+func marshal(v any) json.RawMessage {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil
+	}
+	return data
+}
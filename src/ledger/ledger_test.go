@@ -0,0 +1,239 @@
+// Copyright 2026 H0llyW00dzZ
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ledger
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/H0llyW00dzZ/gspay-go-sdk/src/constants"
+	"github.com/H0llyW00dzZ/gspay-go-sdk/src/errors"
+	"github.com/H0llyW00dzZ/gspay-go-sdk/src/i18n"
+	"github.com/H0llyW00dzZ/gspay-go-sdk/src/payment"
+	"github.com/H0llyW00dzZ/gspay-go-sdk/src/payout"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeClock is a manually advanced clock for deterministic timestamps.
+type fakeClock struct{ t time.Time }
+
+func newFakeClock() *fakeClock {
+	return &fakeClock{t: time.Date(2026, 1, 26, 10, 0, 0, 0, time.UTC)}
+}
+
+func (c *fakeClock) Now() time.Time          { return c.t }
+func (c *fakeClock) Advance(d time.Duration) { c.t = c.t.Add(d) }
+
+func newTestLedger(clock *fakeClock) *Ledger {
+	return New(NewMemoryStore(), WithClock(clock.Now))
+}
+
+func idrRequest(txID string) *payment.IDRRequest {
+	return &payment.IDRRequest{TransactionID: txID, Username: "user123", Amount: 50000}
+}
+
+func TestLedger_RecordIDRPayment(t *testing.T) {
+	t.Run("records request and response", func(t *testing.T) {
+		clock := newFakeClock()
+		l := newTestLedger(clock)
+
+		err := l.RecordIDRPayment(t.Context(), idrRequest("TXN123456789"), &payment.IDRResponse{
+			IDRPaymentID:  "PAY123",
+			TransactionID: "TXN123456789",
+			Amount:        "50000",
+			Status:        "0",
+		}, nil)
+		require.NoError(t, err)
+
+		rec, err := l.Get(t.Context(), "TXN123456789")
+		require.NoError(t, err)
+		assert.Equal(t, KindPaymentIDR, rec.Kind)
+		assert.Equal(t, "PAY123", rec.ProviderID)
+		assert.Equal(t, "user123", rec.Username)
+		assert.Equal(t, "50000", rec.Amount)
+		assert.Equal(t, constants.StatusPending, rec.Status)
+		assert.True(t, rec.IsPending())
+		assert.Equal(t, clock.Now(), rec.CreatedAt)
+		require.Len(t, rec.Entries, 2)
+		assert.Equal(t, EntryRequest, rec.Entries[0].Type)
+		assert.Equal(t, EntryResponse, rec.Entries[1].Type)
+		assert.JSONEq(t, `{"transaction_id":"TXN123456789","player_username":"user123","amount":50000}`, string(rec.Entries[0].Data))
+	})
+
+	t.Run("records error when create fails", func(t *testing.T) {
+		l := newTestLedger(newFakeClock())
+
+		err := l.RecordIDRPayment(t.Context(), idrRequest("TXN123456789"), nil, fmt.Errorf("boom"))
+		require.NoError(t, err)
+
+		rec, err := l.Get(t.Context(), "TXN123456789")
+		require.NoError(t, err)
+		require.Len(t, rec.Entries, 2)
+		assert.Equal(t, EntryError, rec.Entries[1].Type)
+		assert.Equal(t, "boom", rec.Entries[1].Error)
+		assert.Empty(t, rec.ProviderID)
+	})
+}
+
+func TestLedger_Callbacks(t *testing.T) {
+	t.Run("IDR payment callback marks success and callback received", func(t *testing.T) {
+		l := newTestLedger(newFakeClock())
+		require.NoError(t, l.RecordIDRPayment(t.Context(), idrRequest("TXN123456789"), &payment.IDRResponse{IDRPaymentID: "PAY123"}, nil))
+
+		err := l.RecordIDRPaymentCallback(t.Context(), &payment.IDRCallback{
+			IDRPaymentID:  "PAY123",
+			TransactionID: "TXN123456789",
+			Amount:        "50000.00",
+			Status:        constants.StatusSuccess,
+		})
+		require.NoError(t, err)
+
+		rec, err := l.FindByProviderID(t.Context(), KindPaymentIDR, "PAY123")
+		require.NoError(t, err)
+		assert.True(t, rec.IsSuccess())
+		assert.True(t, rec.Completed)
+		assert.True(t, rec.CallbackReceived)
+		assert.False(t, rec.CallbackAt.IsZero())
+	})
+
+	t.Run("USDT payment callback", func(t *testing.T) {
+		l := newTestLedger(newFakeClock())
+		req := &payment.USDTRequest{TransactionID: "USD123456789", Username: "user123", Amount: 10.5}
+		require.NoError(t, l.RecordUSDTPayment(t.Context(), req, &payment.USDTResponse{CryptoPaymentID: "CRYPTO1"}, nil))
+		require.NoError(t, l.RecordUSDTPaymentCallback(t.Context(), &payment.USDTCallback{
+			CryptoPaymentID: "CRYPTO1",
+			TransactionID:   "USD123456789",
+			Amount:          "10.50",
+			Status:          constants.StatusFailed,
+		}))
+
+		rec, err := l.Get(t.Context(), "USD123456789")
+		require.NoError(t, err)
+		assert.Equal(t, KindPaymentUSDT, rec.Kind)
+		assert.Equal(t, "10.50", rec.Amount)
+		assert.Equal(t, constants.StatusFailed, rec.Status)
+		assert.True(t, rec.Completed)
+	})
+
+	t.Run("IDR payout callback derives status from flags", func(t *testing.T) {
+		l := newTestLedger(newFakeClock())
+		req := &payout.IDRRequest{TransactionID: "PAY123456789", Username: "user123", Amount: 50000}
+		require.NoError(t, l.RecordIDRPayout(t.Context(), req, &payout.IDRResponse{IDRPayoutID: "99"}, nil))
+
+		require.NoError(t, l.RecordIDRPayoutCallback(t.Context(), &payout.IDRCallback{
+			IDRPayoutID:   "99",
+			TransactionID: "PAY123456789",
+			Completed:     true,
+			PayoutSuccess: false,
+		}))
+
+		rec, err := l.FindByProviderID(t.Context(), KindPayoutIDR, "99")
+		require.NoError(t, err)
+		assert.Equal(t, constants.StatusFailed, rec.Status)
+		assert.True(t, rec.Completed)
+	})
+}
+
+func TestLedger_Status(t *testing.T) {
+	t.Run("IDR payment status poll", func(t *testing.T) {
+		l := newTestLedger(newFakeClock())
+		require.NoError(t, l.RecordIDRPaymentStatus(t.Context(), &payment.IDRStatusResponse{
+			IDRPaymentID:  "PAY123",
+			TransactionID: "TXN123456789",
+			Status:        constants.StatusSuccess,
+			Amount:        "50000.00",
+			Completed:     true,
+		}))
+
+		rec, err := l.Get(t.Context(), "TXN123456789")
+		require.NoError(t, err)
+		assert.Equal(t, KindPaymentIDR, rec.Kind)
+		assert.True(t, rec.IsSuccess())
+		assert.False(t, rec.CallbackReceived)
+		assert.Equal(t, EntryStatus, rec.Entries[0].Type)
+	})
+
+	t.Run("IDR payout status poll", func(t *testing.T) {
+		l := newTestLedger(newFakeClock())
+		require.NoError(t, l.RecordIDRPayoutStatus(t.Context(), &payout.IDRStatusResponse{
+			IDRPayoutID:   "99",
+			TransactionID: "PAY123456789",
+			Completed:     true,
+			PayoutSuccess: true,
+		}))
+
+		rec, err := l.Get(t.Context(), "PAY123456789")
+		require.NoError(t, err)
+		assert.Equal(t, constants.StatusSuccess, rec.Status)
+	})
+}
+
+func TestLedger_Queries(t *testing.T) {
+	clock := newFakeClock()
+	l := newTestLedger(clock)
+
+	require.NoError(t, l.RecordIDRPayment(t.Context(), idrRequest("TXN-OLD-PENDING"), &payment.IDRResponse{IDRPaymentID: "1"}, nil))
+	clock.Advance(20 * time.Minute)
+	require.NoError(t, l.RecordIDRPayment(t.Context(), idrRequest("TXN-NEW-PENDING"), &payment.IDRResponse{IDRPaymentID: "2"}, nil))
+	require.NoError(t, l.RecordIDRPaymentStatus(t.Context(), &payment.IDRStatusResponse{
+		IDRPaymentID:  "3",
+		TransactionID: "TXN-POLLED-OK",
+		Status:        constants.StatusSuccess,
+	}))
+	require.NoError(t, l.RecordIDRPaymentCallback(t.Context(), &payment.IDRCallback{
+		IDRPaymentID:  "4",
+		TransactionID: "TXN-CALLBACK-OK",
+		Status:        constants.StatusSuccess,
+	}))
+	clock.Advance(5 * time.Minute)
+
+	t.Run("pending longer than", func(t *testing.T) {
+		recs, err := l.PendingLongerThan(t.Context(), 15*time.Minute)
+		require.NoError(t, err)
+		require.Len(t, recs, 1)
+		assert.Equal(t, "TXN-OLD-PENDING", recs[0].TransactionID)
+	})
+
+	t.Run("succeeded without callback", func(t *testing.T) {
+		recs, err := l.SucceededWithoutCallback(t.Context())
+		require.NoError(t, err)
+		require.Len(t, recs, 1)
+		assert.Equal(t, "TXN-POLLED-OK", recs[0].TransactionID)
+	})
+
+	t.Run("combined filters", func(t *testing.T) {
+		recs, err := l.List(t.Context(), All(ByKind(KindPaymentIDR), PendingSince(clock.Now())))
+		require.NoError(t, err)
+		assert.Len(t, recs, 2)
+
+		recs, err = l.List(t.Context(), ByKind(KindPayoutIDR))
+		require.NoError(t, err)
+		assert.Empty(t, recs)
+	})
+}
+
+func TestLedger_NotFound(t *testing.T) {
+	l := New(NewMemoryStore(), WithLanguage(i18n.Indonesian))
+
+	_, err := l.Get(t.Context(), "missing")
+	require.Error(t, err)
+	assert.ErrorIs(t, err, errors.ErrRecordNotFound)
+	assert.Contains(t, err.Error(), "catatan ledger tidak ditemukan")
+
+	_, err = l.FindByProviderID(t.Context(), KindPaymentIDR, "missing")
+	assert.ErrorIs(t, err, errors.ErrRecordNotFound)
+}
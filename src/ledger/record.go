@@ -0,0 +1,171 @@
+// Copyright 2026 H0llyW00dzZ
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ledger
+
+import (
+	"encoding/json"
+	"slices"
+	"time"
+
+	"github.com/H0llyW00dzZ/gspay-go-sdk/src/constants"
+)
+
+// Kind identifies the type of transaction recorded in the ledger.
+type Kind string
+
+// Supported transaction kinds.
+const (
+	// KindPaymentIDR is an IDR payment (see payment.IDRService).
+	KindPaymentIDR Kind = "payment_idr"
+	// KindPaymentUSDT is a USDT payment (see payment.USDTService).
+	KindPaymentUSDT Kind = "payment_usdt"
+	// KindPayoutIDR is an IDR payout (see payout.IDRService).
+	KindPayoutIDR Kind = "payout_idr"
+)
+
+// EntryType identifies what produced a ledger [Entry].
+type EntryType string
+
+// Supported entry types.
+const (
+	// EntryRequest records a create request sent to GSPAY2.
+	EntryRequest EntryType = "request"
+	// EntryResponse records a successful create response from GSPAY2.
+	EntryResponse EntryType = "response"
+	// EntryError records a create request that failed.
+	EntryError EntryType = "error"
+	// EntryStatus records the result of a status poll.
+	EntryStatus EntryType = "status"
+	// EntryCallback records a verified callback.
+	EntryCallback EntryType = "callback"
+)
+
+// Entry is a single immutable event in the history of a transaction.
+type Entry struct {
+	// Type is the source of the entry.
+	Type EntryType `json:"type"`
+	// At is the time the entry was recorded.
+	At time.Time `json:"at"`
+	// Status is the status reported by this entry, if any.
+	Status constants.PaymentStatus `json:"status"`
+	// Amount is the amount reported by this entry, if any.
+	Amount string `json:"amount,omitempty"`
+	// Error is the error message for [EntryError] entries.
+	Error string `json:"error,omitempty"`
+	// Data is the JSON encoding of the request, response, or callback.
+	Data json.RawMessage `json:"data,omitempty"`
+}
+
+// Record is the aggregated view of a single transaction.
+//
+// Records are keyed by TransactionID and can also be looked up by the
+// GSPAY2-assigned ProviderID once a response or callback has been recorded.
+type Record struct {
+	// TransactionID is the merchant-side transaction ID.
+	TransactionID string `json:"transaction_id"`
+	// ProviderID is the GSPAY2-assigned ID (idrpayment_id, cryptopayment_id or idrpayout_id).
+	ProviderID string `json:"provider_id,omitempty"`
+	// Kind is the transaction type.
+	Kind Kind `json:"kind"`
+	// Username is the customer ID or username.
+	Username string `json:"username,omitempty"`
+	// Amount is the requested amount.
+	Amount string `json:"amount,omitempty"`
+	// Status is the latest known status.
+	Status constants.PaymentStatus `json:"status"`
+	// Completed indicates the transaction reached a final state.
+	Completed bool `json:"completed"`
+	// CallbackReceived indicates a verified callback was recorded.
+	CallbackReceived bool `json:"callback_received"`
+	// CreatedAt is the time the first entry was recorded.
+	CreatedAt time.Time `json:"created_at"`
+	// UpdatedAt is the time the latest entry was recorded.
+	UpdatedAt time.Time `json:"updated_at"`
+	// CallbackAt is the time the latest callback was recorded.
+	CallbackAt time.Time `json:"callback_at,omitzero"`
+	// Entries is the full history of the transaction, oldest first.
+	Entries []Entry `json:"entries"`
+}
+
+// IsPending returns true if the transaction has not reached a final state.
+func (r *Record) IsPending() bool { return !r.Completed && r.Status.IsPending() }
+
+// IsSuccess returns true if the latest known status is successful.
+func (r *Record) IsSuccess() bool { return r.Status.IsSuccess() }
+
+// Clone returns a deep copy of the record.
+func (r *Record) Clone() *Record {
+	if r == nil {
+		return nil
+	}
+	c := *r
+	c.Entries = make([]Entry, len(r.Entries))
+	for i, e := range r.Entries {
+		e.Data = slices.Clone(e.Data)
+		c.Entries[i] = e
+	}
+	return &c
+}
+
+// append adds an entry to the record and updates the aggregated fields.
+func (r *Record) append(e Entry) {
+	if r.CreatedAt.IsZero() {
+		r.CreatedAt = e.At
+	}
+	r.UpdatedAt = e.At
+	r.Entries = append(r.Entries, e)
+
+	if e.Type == EntryCallback {
+		r.CallbackReceived = true
+		r.CallbackAt = e.At
+	}
+}
+
+// Filter reports whether a record should be included in a listing.
+//
+// A nil Filter matches every record.
+type Filter func(rec *Record) bool
+
+// Match reports whether rec matches the filter.
+func (f Filter) Match(rec *Record) bool { return f == nil || f(rec) }
+
+// All returns a [Filter] matching records that match every given filter.
+func All(filters ...Filter) Filter {
+	return func(rec *Record) bool {
+		for _, f := range filters {
+			if !f.Match(rec) {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+// ByKind returns a [Filter] matching records of the given kind.
+func ByKind(kind Kind) Filter {
+	return func(rec *Record) bool { return rec.Kind == kind }
+}
+
+// PendingSince returns a [Filter] matching records that are still pending
+// and were created before the cutoff.
+func PendingSince(cutoff time.Time) Filter {
+	return func(rec *Record) bool { return rec.IsPending() && rec.CreatedAt.Before(cutoff) }
+}
+
+// SucceededWithoutCallback returns a [Filter] matching records that are known
+// to be successful (from a status poll) but have no recorded callback.
+func SucceededWithoutCallback() Filter {
+	return func(rec *Record) bool { return rec.IsSuccess() && !rec.CallbackReceived }
+}
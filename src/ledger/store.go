@@ -0,0 +1,160 @@
+// Copyright 2026 H0llyW00dzZ
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ledger
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/H0llyW00dzZ/gspay-go-sdk/src/errors"
+)
+
+// Store is the persistence interface for ledger records.
+//
+// Implementations must be safe for concurrent use by multiple goroutines.
+// Records passed to and returned from a Store are owned by the caller;
+// implementations must copy them rather than retain references.
+//
+// Built-in implementations:
+//   - [MemoryStore]: In-memory storage, lost on restart
+//   - [FileStore]: Embedded JSON file storage with atomic writes
+type Store interface {
+	// Update atomically loads the record for transactionID, applies fn,
+	// and persists the result. If no record exists, fn receives a new
+	// record with only TransactionID set. If fn returns an error,
+	// nothing is persisted and the error is returned.
+	Update(ctx context.Context, transactionID string, fn func(rec *Record) error) error
+	// Get returns the record for transactionID.
+	// Returns [errors.ErrRecordNotFound] if no record exists.
+	Get(ctx context.Context, transactionID string) (*Record, error)
+	// FindByProviderID returns the record of the given kind with the GSPAY2-assigned ID.
+	// Returns [errors.ErrRecordNotFound] if no record exists.
+	FindByProviderID(ctx context.Context, kind Kind, providerID string) (*Record, error)
+	// List returns all records matching filter, ordered by creation time.
+	List(ctx context.Context, filter Filter) ([]*Record, error)
+}
+
+// records is the shared in-memory index used by the built-in stores.
+type records struct {
+	byTxID map[string]*Record
+}
+
+// newRecords creates an empty record index.
+func newRecords() records { return records{byTxID: make(map[string]*Record)} }
+
+// update applies fn to a copy of the record and stores it on success.
+func (rs records) update(transactionID string, fn func(rec *Record) error) (*Record, error) {
+	rec := rs.byTxID[transactionID].Clone()
+	if rec == nil {
+		rec = &Record{TransactionID: transactionID}
+	}
+	if err := fn(rec); err != nil {
+		return nil, err
+	}
+	rec.TransactionID = transactionID
+	rs.byTxID[transactionID] = rec
+	return rec, nil
+}
+
+// get returns a copy of the record for transactionID.
+func (rs records) get(transactionID string) (*Record, error) {
+	rec, ok := rs.byTxID[transactionID]
+	if !ok {
+		return nil, errors.ErrRecordNotFound
+	}
+	return rec.Clone(), nil
+}
+
+// findByProviderID returns a copy of the record with the given provider ID.
+func (rs records) findByProviderID(kind Kind, providerID string) (*Record, error) {
+	for _, rec := range rs.byTxID {
+		if rec.Kind == kind && rec.ProviderID == providerID {
+			return rec.Clone(), nil
+		}
+	}
+	return nil, errors.ErrRecordNotFound
+}
+
+// list returns copies of all records matching filter, oldest first.
+func (rs records) list(filter Filter) []*Record {
+	var out []*Record
+	for _, rec := range rs.byTxID {
+		if filter.Match(rec) {
+			out = append(out, rec.Clone())
+		}
+	}
+	slices.SortFunc(out, func(a, b *Record) int {
+		if c := a.CreatedAt.Compare(b.CreatedAt); c != 0 {
+			return c
+		}
+		return strings.Compare(a.TransactionID, b.TransactionID)
+	})
+	return out
+}
+
+// MemoryStore is an in-memory [Store].
+//
+// Records are lost when the process exits. It is suitable for tests and
+// for short-lived processes that only need in-process reconciliation.
+type MemoryStore struct {
+	mu   sync.RWMutex
+	recs records
+}
+
+// NewMemoryStore creates an empty [MemoryStore].
+func NewMemoryStore() *MemoryStore { return &MemoryStore{recs: newRecords()} }
+
+// Update implements [Store.Update].
+func (s *MemoryStore) Update(ctx context.Context, transactionID string, fn func(rec *Record) error) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	_, err := s.recs.update(transactionID, fn)
+	return err
+}
+
+// Get implements [Store.Get].
+func (s *MemoryStore) Get(ctx context.Context, transactionID string) (*Record, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.recs.get(transactionID)
+}
+
+// FindByProviderID implements [Store.FindByProviderID].
+func (s *MemoryStore) FindByProviderID(ctx context.Context, kind Kind, providerID string) (*Record, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.recs.findByProviderID(kind, providerID)
+}
+
+// List implements [Store.List].
+func (s *MemoryStore) List(ctx context.Context, filter Filter) ([]*Record, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.recs.list(filter), nil
+}
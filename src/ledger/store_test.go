@@ -0,0 +1,176 @@
+// Copyright 2026 H0llyW00dzZ
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ledger
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/H0llyW00dzZ/gspay-go-sdk/src/constants"
+	"github.com/H0llyW00dzZ/gspay-go-sdk/src/errors"
+	"github.com/H0llyW00dzZ/gspay-go-sdk/src/payment"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// paymentStatusFixture is a successful IDR payment status poll.
+var paymentStatusFixture = payment.IDRStatusResponse{
+	IDRPaymentID:  "PAY123",
+	TransactionID: "TXN123456789",
+	Status:        constants.StatusSuccess,
+	Amount:        "50000.00",
+	Completed:     true,
+}
+
+func TestStores(t *testing.T) {
+	stores := map[string]func(t *testing.T) Store{
+		"memory": func(t *testing.T) Store { return NewMemoryStore() },
+		"file": func(t *testing.T) Store {
+			s, err := OpenFileStore(filepath.Join(t.TempDir(), "ledger.json"))
+			require.NoError(t, err)
+			return s
+		},
+	}
+
+	for name, newStore := range stores {
+		t.Run(name, func(t *testing.T) {
+			t.Run("update creates and modifies records", func(t *testing.T) {
+				s := newStore(t)
+				require.NoError(t, s.Update(t.Context(), "TXN1", func(rec *Record) error {
+					rec.Kind = KindPaymentIDR
+					rec.ProviderID = "P1"
+					return nil
+				}))
+
+				rec, err := s.Get(t.Context(), "TXN1")
+				require.NoError(t, err)
+				assert.Equal(t, "TXN1", rec.TransactionID)
+
+				rec, err = s.FindByProviderID(t.Context(), KindPaymentIDR, "P1")
+				require.NoError(t, err)
+				assert.Equal(t, "TXN1", rec.TransactionID)
+			})
+
+			t.Run("update error discards changes", func(t *testing.T) {
+				s := newStore(t)
+				err := s.Update(t.Context(), "TXN1", func(rec *Record) error {
+					rec.Kind = KindPaymentIDR
+					return fmt.Errorf("abort")
+				})
+				assert.EqualError(t, err, "abort")
+
+				_, err = s.Get(t.Context(), "TXN1")
+				assert.ErrorIs(t, err, errors.ErrRecordNotFound)
+			})
+
+			t.Run("returned records are copies", func(t *testing.T) {
+				s := newStore(t)
+				require.NoError(t, s.Update(t.Context(), "TXN1", func(rec *Record) error {
+					rec.append(Entry{Type: EntryRequest, Data: []byte(`{}`)})
+					return nil
+				}))
+
+				rec, err := s.Get(t.Context(), "TXN1")
+				require.NoError(t, err)
+				rec.Status = constants.StatusSuccess
+				rec.Entries[0].Data[0] = 'x'
+
+				again, err := s.Get(t.Context(), "TXN1")
+				require.NoError(t, err)
+				assert.Equal(t, constants.StatusPending, again.Status)
+				assert.Equal(t, `{}`, string(again.Entries[0].Data))
+			})
+
+			t.Run("concurrent updates are serialized", func(t *testing.T) {
+				s := newStore(t)
+				var wg sync.WaitGroup
+				for range 20 {
+					wg.Go(func() {
+						assert.NoError(t, s.Update(t.Context(), "TXN1", func(rec *Record) error {
+							rec.append(Entry{Type: EntryStatus})
+							return nil
+						}))
+					})
+				}
+				wg.Wait()
+
+				rec, err := s.Get(t.Context(), "TXN1")
+				require.NoError(t, err)
+				assert.Len(t, rec.Entries, 20)
+			})
+
+			t.Run("respects canceled context", func(t *testing.T) {
+				s := newStore(t)
+				ctx, cancel := context.WithCancel(t.Context())
+				cancel()
+
+				assert.ErrorIs(t, s.Update(ctx, "TXN1", func(*Record) error { return nil }), context.Canceled)
+				_, err := s.List(ctx, nil)
+				assert.ErrorIs(t, err, context.Canceled)
+			})
+		})
+	}
+}
+
+func TestFileStore_Persistence(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "ledger.json")
+
+	s, err := OpenFileStore(path)
+	require.NoError(t, err)
+	assert.Equal(t, path, s.Path())
+
+	l := New(s)
+	require.NoError(t, l.RecordIDRPaymentStatus(t.Context(), &paymentStatusFixture))
+
+	reopened, err := OpenFileStore(path)
+	require.NoError(t, err)
+
+	rec, err := reopened.Get(t.Context(), paymentStatusFixture.TransactionID)
+	require.NoError(t, err)
+	assert.Equal(t, constants.StatusSuccess, rec.Status)
+	assert.Len(t, rec.Entries, 1)
+
+	t.Run("rejects corrupt file", func(t *testing.T) {
+		bad := filepath.Join(t.TempDir(), "bad.json")
+		require.NoError(t, os.WriteFile(bad, []byte("{not json"), 0o600))
+		_, err := OpenFileStore(bad)
+		assert.Error(t, err)
+	})
+
+	t.Run("accepts empty file", func(t *testing.T) {
+		empty := filepath.Join(t.TempDir(), "empty.json")
+		require.NoError(t, os.WriteFile(empty, nil, 0o600))
+		s, err := OpenFileStore(empty)
+		require.NoError(t, err)
+		recs, err := s.List(t.Context(), nil)
+		require.NoError(t, err)
+		assert.Empty(t, recs)
+	})
+
+	t.Run("rolls back on write failure", func(t *testing.T) {
+		s, err := OpenFileStore(filepath.Join(t.TempDir(), "missing-dir", "ledger.json"))
+		require.NoError(t, err)
+
+		err = s.Update(t.Context(), "TXN1", func(*Record) error { return nil })
+		require.Error(t, err)
+
+		_, err = s.Get(t.Context(), "TXN1")
+		assert.ErrorIs(t, err, errors.ErrRecordNotFound)
+	})
+}
@@ -0,0 +1,49 @@
+// Copyright 2026 H0llyW00dzZ
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package lifecycle normalizes GSPAY2 transaction statuses into a single
+// state machine.
+//
+// GSPAY2 reports status differently per product: payments use a status code
+// where 0 means both pending and expired, and payouts use a pair of
+// completed/payout_success flags. This package turns create responses,
+// status polls and verified callbacks for IDR payments, USDT payments and
+// IDR payouts into one [Event] type with a [State]:
+//
+//	new -> pending -> succeeded | failed | expired
+//	expired -> succeeded | failed   (late settlement)
+//
+// Succeeded and failed are final; an event that would leave them, such as a
+// stale pending poll after a success callback, is rejected with
+// [errors.ErrIllegalTransition].
+//
+// # Basic Usage
+//
+//	m := lifecycle.New(lifecycle.KindPaymentIDR, req.TransactionID)
+//	if _, err := m.Apply(lifecycle.FromIDRPayment(resp, time.Now())); err != nil {
+//	    log.Print(err)
+//	}
+//
+//	// Callbacks and polls are applied the same way
+//	changed, err := m.Apply(lifecycle.FromIDRPaymentCallback(&callback, time.Now()))
+//	if changed && m.State() == lifecycle.StateSucceeded {
+//	    // Credit the customer once
+//	}
+//
+// # Expiry
+//
+// The create event carries the payment expire date. A pending event observed
+// after it is recorded as [StateExpired], and [Machine.Expire] expires a
+// pending transaction on a timer without waiting for GSPAY2.
+package lifecycle
@@ -0,0 +1,180 @@
+// Copyright 2026 H0llyW00dzZ
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lifecycle
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/H0llyW00dzZ/gspay-go-sdk/src/constants"
+	"github.com/H0llyW00dzZ/gspay-go-sdk/src/payment"
+	"github.com/H0llyW00dzZ/gspay-go-sdk/src/payout"
+)
+
+// Source identifies what produced an [Event].
+type Source string
+
+// Supported event sources.
+const (
+	// SourceCreate is a create response.
+	SourceCreate Source = "create"
+	// SourceStatus is a status poll.
+	SourceStatus Source = "status"
+	// SourceCallback is a verified callback.
+	SourceCallback Source = "callback"
+	// SourceExpiry is a local expiry detected by [Machine.Expire].
+	SourceExpiry Source = "expiry"
+)
+
+// Event is a normalized observation of a transaction, whatever produced it.
+type Event struct {
+	// Kind is the transaction type.
+	Kind Kind `json:"kind"`
+	// State is the state reported by the event.
+	State State `json:"state"`
+	// TransactionID is the merchant-side transaction ID.
+	TransactionID string `json:"transaction_id"`
+	// ProviderID is the GSPAY2-assigned ID (idrpayment_id, cryptopayment_id or idrpayout_id).
+	ProviderID string `json:"provider_id,omitempty"`
+	// Amount is the amount reported by the event, if any.
+	Amount string `json:"amount,omitempty"`
+	// At is the time the event was observed.
+	At time.Time `json:"at"`
+	// Source is what produced the event.
+	Source Source `json:"source"`
+	// ExpiresAt is the payment expire date, if known. Only create events carry it.
+	ExpiresAt time.Time `json:"expires_at,omitzero"`
+}
+
+// FromIDRPayment returns the event for an IDR payment create response.
+// The expire date is included when it can be parsed.
+func FromIDRPayment(resp *payment.IDRResponse, at time.Time) Event {
+	ev := Event{
+		Kind:          KindPaymentIDR,
+		State:         createState(resp.Status),
+		TransactionID: resp.TransactionID,
+		ProviderID:    resp.IDRPaymentID,
+		Amount:        resp.Amount,
+		At:            at,
+		Source:        SourceCreate,
+	}
+	ev.ExpiresAt, _ = resp.ExpiresAt()
+	return ev
+}
+
+// FromUSDTPayment returns the event for a USDT payment create response.
+// The response has no transaction ID, so it is taken from req.
+func FromUSDTPayment(req *payment.USDTRequest, resp *payment.USDTResponse, at time.Time) Event {
+	ev := Event{
+		Kind:          KindPaymentUSDT,
+		State:         StatePending,
+		TransactionID: req.TransactionID,
+		ProviderID:    resp.CryptoPaymentID,
+		At:            at,
+		Source:        SourceCreate,
+	}
+	ev.ExpiresAt, _ = resp.ExpiresAt()
+	return ev
+}
+
+// FromIDRPayout returns the event for an IDR payout create response.
+// The response has no transaction ID or amount, so they are taken from req.
+func FromIDRPayout(req *payout.IDRRequest, resp *payout.IDRResponse, at time.Time) Event {
+	return Event{
+		Kind:          KindPayoutIDR,
+		State:         FromStatus(resp.Status),
+		TransactionID: req.TransactionID,
+		ProviderID:    string(resp.IDRPayoutID),
+		Amount:        strconv.FormatInt(req.Amount, 10),
+		At:            at,
+		Source:        SourceCreate,
+	}
+}
+
+// FromIDRPaymentStatus returns the event for an IDR payment status poll.
+func FromIDRPaymentStatus(status *payment.IDRStatusResponse, at time.Time) Event {
+	return Event{
+		Kind:          KindPaymentIDR,
+		State:         FromStatus(status.Status),
+		TransactionID: status.TransactionID,
+		ProviderID:    string(status.IDRPaymentID),
+		Amount:        string(status.Amount),
+		At:            at,
+		Source:        SourceStatus,
+	}
+}
+
+// FromIDRPayoutStatus returns the event for an IDR payout status poll.
+func FromIDRPayoutStatus(status *payout.IDRStatusResponse, at time.Time) Event {
+	return Event{
+		Kind:          KindPayoutIDR,
+		State:         FromStatus(status.PaymentStatus()),
+		TransactionID: status.TransactionID,
+		ProviderID:    string(status.IDRPayoutID),
+		Amount:        string(status.Amount),
+		At:            at,
+		Source:        SourceStatus,
+	}
+}
+
+// FromIDRPaymentCallback returns the event for a verified IDR payment callback.
+func FromIDRPaymentCallback(callback *payment.IDRCallback, at time.Time) Event {
+	return Event{
+		Kind:          KindPaymentIDR,
+		State:         FromStatus(callback.Status),
+		TransactionID: callback.TransactionID,
+		ProviderID:    string(callback.IDRPaymentID),
+		Amount:        string(callback.Amount),
+		At:            at,
+		Source:        SourceCallback,
+	}
+}
+
+// FromUSDTPaymentCallback returns the event for a verified USDT payment callback.
+func FromUSDTPaymentCallback(callback *payment.USDTCallback, at time.Time) Event {
+	return Event{
+		Kind:          KindPaymentUSDT,
+		State:         FromStatus(callback.Status),
+		TransactionID: callback.TransactionID,
+		ProviderID:    callback.CryptoPaymentID,
+		Amount:        callback.Amount,
+		At:            at,
+		Source:        SourceCallback,
+	}
+}
+
+// FromIDRPayoutCallback returns the event for a verified IDR payout callback.
+// The completed and payout_success flags are folded into a single state.
+func FromIDRPayoutCallback(callback *payout.IDRCallback, at time.Time) Event {
+	return Event{
+		Kind:          KindPayoutIDR,
+		State:         FromStatus(callback.PaymentStatus()),
+		TransactionID: callback.TransactionID,
+		ProviderID:    string(callback.IDRPayoutID),
+		Amount:        string(callback.Amount),
+		At:            at,
+		Source:        SourceCallback,
+	}
+}
+
+// createState maps the string status of a create response, treating an
+// unparseable value as pending.
+func createState(status string) State {
+	n, err := strconv.Atoi(status)
+	if err != nil {
+		return StatePending
+	}
+	return FromStatus(constants.ParsePaymentStatus(n))
+}
@@ -0,0 +1,106 @@
+// Copyright 2026 H0llyW00dzZ
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lifecycle
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/H0llyW00dzZ/gspay-go-sdk/src/constants"
+	"github.com/H0llyW00dzZ/gspay-go-sdk/src/payment"
+	"github.com/H0llyW00dzZ/gspay-go-sdk/src/payout"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEvents(t *testing.T) {
+	at := time.Date(2026, 1, 26, 15, 0, 0, 0, time.UTC)
+
+	t.Run("IDR payment create", func(t *testing.T) {
+		ev := FromIDRPayment(&payment.IDRResponse{
+			IDRPaymentID:  "PAY123",
+			TransactionID: "TXN123456789",
+			Amount:        "50000",
+			ExpireDate:    "2026-01-26 22:15:00",
+			Status:        "0",
+		}, at)
+		assert.Equal(t, KindPaymentIDR, ev.Kind)
+		assert.Equal(t, StatePending, ev.State)
+		assert.Equal(t, "PAY123", ev.ProviderID)
+		assert.Equal(t, SourceCreate, ev.Source)
+		assert.True(t, at.Add(15*time.Minute).Equal(ev.ExpiresAt))
+	})
+
+	t.Run("USDT payment create", func(t *testing.T) {
+		ev := FromUSDTPayment(
+			&payment.USDTRequest{TransactionID: "TXN123456789"},
+			&payment.USDTResponse{CryptoPaymentID: "CRYPTO123", ExpireDate: "unknown"},
+			at,
+		)
+		assert.Equal(t, "TXN123456789", ev.TransactionID)
+		assert.Equal(t, "CRYPTO123", ev.ProviderID)
+		assert.True(t, ev.ExpiresAt.IsZero())
+	})
+
+	t.Run("IDR payout create", func(t *testing.T) {
+		ev := FromIDRPayout(
+			&payout.IDRRequest{TransactionID: "TXN123456789", Amount: 50000},
+			&payout.IDRResponse{IDRPayoutID: "123", Status: constants.StatusPending},
+			at,
+		)
+		assert.Equal(t, KindPayoutIDR, ev.Kind)
+		assert.Equal(t, StatePending, ev.State)
+		assert.Equal(t, "123", ev.ProviderID)
+		assert.Equal(t, "50000", ev.Amount)
+	})
+
+	t.Run("status polls", func(t *testing.T) {
+		ev := FromIDRPaymentStatus(&payment.IDRStatusResponse{TransactionID: "TXN123456789", Status: constants.StatusTimeout}, at)
+		assert.Equal(t, StateExpired, ev.State)
+		assert.Equal(t, SourceStatus, ev.Source)
+
+		ev = FromIDRPayoutStatus(&payout.IDRStatusResponse{TransactionID: "TXN123456789", Completed: true}, at)
+		assert.Equal(t, StateFailed, ev.State)
+	})
+
+	t.Run("callbacks", func(t *testing.T) {
+		ev := FromIDRPaymentCallback(&payment.IDRCallback{TransactionID: "TXN123456789", Amount: json.Number("50000.00"), Status: constants.StatusSuccess}, at)
+		assert.Equal(t, StateSucceeded, ev.State)
+		assert.Equal(t, "50000.00", ev.Amount)
+		assert.Equal(t, SourceCallback, ev.Source)
+
+		ev = FromUSDTPaymentCallback(&payment.USDTCallback{TransactionID: "TXN123456789", Status: constants.StatusFailed}, at)
+		assert.Equal(t, KindPaymentUSDT, ev.Kind)
+		assert.Equal(t, StateFailed, ev.State)
+
+		ev = FromIDRPayoutCallback(&payout.IDRCallback{TransactionID: "TXN123456789"}, at)
+		assert.Equal(t, StatePending, ev.State)
+		ev = FromIDRPayoutCallback(&payout.IDRCallback{TransactionID: "TXN123456789", Completed: true, PayoutSuccess: true}, at)
+		assert.Equal(t, StateSucceeded, ev.State)
+	})
+
+	t.Run("polls and callbacks drive the same machine", func(t *testing.T) {
+		m := New(KindPayoutIDR, "TXN123456789")
+		_, err := m.Apply(FromIDRPayoutStatus(&payout.IDRStatusResponse{TransactionID: "TXN123456789"}, at))
+		require.NoError(t, err)
+		_, err = m.Apply(FromIDRPayoutCallback(&payout.IDRCallback{TransactionID: "TXN123456789", Completed: true, PayoutSuccess: true}, at.Add(time.Minute)))
+		require.NoError(t, err)
+		_, err = m.Apply(FromIDRPayoutStatus(&payout.IDRStatusResponse{TransactionID: "TXN123456789"}, at.Add(2*time.Minute)))
+		assert.Error(t, err)
+		assert.Equal(t, StateSucceeded, m.State())
+	})
+}
@@ -0,0 +1,216 @@
+// Copyright 2026 H0llyW00dzZ
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lifecycle
+
+import (
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/H0llyW00dzZ/gspay-go-sdk/src/errors"
+	"github.com/H0llyW00dzZ/gspay-go-sdk/src/i18n"
+)
+
+// Machine tracks the [State] of a single transaction.
+//
+// Events from create responses, status polls and callbacks are fed to
+// [Machine.Apply] in whatever order they arrive. Illegal transitions, such
+// as succeeded to pending, are rejected. A Machine is safe for concurrent use.
+type Machine struct {
+	mu            sync.Mutex
+	kind          Kind
+	transactionID string
+	providerID    string
+	state         State
+	expiresAt     time.Time
+	history       []Event
+	lang          i18n.Language
+	now           func() time.Time
+	onIgnored     func(Event)
+}
+
+// Option is a functional option for configuring a [Machine].
+type Option func(*Machine)
+
+// WithLanguage sets the language for localized errors.
+// Default is [i18n.English].
+func WithLanguage(lang i18n.Language) Option {
+	return func(m *Machine) {
+		if lang.IsValid() {
+			m.lang = lang
+		}
+	}
+}
+
+// WithClock sets the function used to timestamp events that have no time.
+// Default is [time.Now]. This is mainly useful for tests.
+func WithClock(now func() time.Time) Option {
+	return func(m *Machine) {
+		if now != nil {
+			m.now = now
+		}
+	}
+}
+
+// WithIgnoredHandler sets a function called with every pending event that
+// [Machine.Apply] ignores because the transaction has already expired. The
+// handler runs after the machine is unlocked, so it may call the machine.
+func WithIgnoredHandler(fn func(ev Event)) Option {
+	return func(m *Machine) { m.onIgnored = fn }
+}
+
+// WithExpiry sets the payment expire date. It is normally learned from the
+// create event; use this option when the machine is restored from storage.
+func WithExpiry(expiresAt time.Time) Option {
+	return func(m *Machine) { m.expiresAt = expiresAt }
+}
+
+// New creates a [Machine] in [StateNew] for the given transaction.
+//
+// Example:
+//
+//	m := lifecycle.New(lifecycle.KindPaymentIDR, req.TransactionID)
+//	m.Apply(lifecycle.FromIDRPayment(resp, time.Now()))
+//
+//	// Later, in the callback handler
+//	changed, err := m.Apply(lifecycle.FromIDRPaymentCallback(&callback, time.Now()))
+func New(kind Kind, transactionID string, opts ...Option) *Machine {
+	m := &Machine{
+		kind:          kind,
+		transactionID: transactionID,
+		state:         StateNew,
+		lang:          i18n.English,
+		now:           time.Now,
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// Apply moves the machine to the state reported by ev.
+//
+// It returns true if the state changed; repeating the current state, such as
+// a duplicate callback, returns false and no error. A pending event observed
+// at or after the expire date is treated as [StateExpired]. A pending event
+// for an expired transaction, such as a stale status poll, is ignored and
+// returns false and no error, since GSPAY2 reports both with status 0; use
+// [WithIgnoredHandler] to observe these events.
+//
+// An event for another kind or transaction returns an error wrapping
+// [errors.ErrEventMismatch]. An illegal transition returns an error wrapping
+// [errors.ErrIllegalTransition] and leaves the machine unchanged.
+func (m *Machine) Apply(ev Event) (bool, error) {
+	changed, ignored, err := m.apply(ev)
+	if ignored && m.onIgnored != nil {
+		m.onIgnored(ev)
+	}
+	return changed, err
+}
+
+// apply implements [Machine.Apply]. ignored reports a pending event dropped
+// because the transaction has expired.
+func (m *Machine) apply(ev Event) (changed, ignored bool, err error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if ev.Kind != m.kind || (ev.TransactionID != "" && ev.TransactionID != m.transactionID) {
+		return false, false, errors.New(m.lang, errors.ErrEventMismatch,
+			fmt.Sprintf("%s %s, want %s %s", ev.Kind, ev.TransactionID, m.kind, m.transactionID))
+	}
+	if ev.At.IsZero() {
+		ev.At = m.now()
+	}
+	ev.TransactionID = m.transactionID
+	if !ev.ExpiresAt.IsZero() {
+		m.expiresAt = ev.ExpiresAt
+	}
+	if ev.State == StatePending && m.pastExpiry(ev.At) {
+		ev.State = StateExpired
+	}
+	if ev.State == StatePending && m.state == StateExpired {
+		return false, true, nil
+	}
+	if !CanTransition(m.state, ev.State) {
+		return false, false, errors.New(m.lang, errors.ErrIllegalTransition,
+			fmt.Sprintf("%s -> %s", m.state, ev.State))
+	}
+	if ev.ProviderID != "" {
+		m.providerID = ev.ProviderID
+	}
+	if ev.State == m.state {
+		return false, false, nil
+	}
+	m.state = ev.State
+	m.history = append(m.history, ev)
+	return true, false, nil
+}
+
+// Expire moves a pending transaction to [StateExpired] if its expire date
+// has passed at now. It returns the expiry event and true if the state changed.
+func (m *Machine) Expire(now time.Time) (Event, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.state != StatePending || !m.pastExpiry(now) {
+		return Event{}, false
+	}
+	ev := Event{
+		Kind:          m.kind,
+		State:         StateExpired,
+		TransactionID: m.transactionID,
+		ProviderID:    m.providerID,
+		At:            now,
+		Source:        SourceExpiry,
+		ExpiresAt:     m.expiresAt,
+	}
+	m.state = StateExpired
+	m.history = append(m.history, ev)
+	return ev, true
+}
+
+// State returns the current state.
+func (m *Machine) State() State {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.state
+}
+
+// ProviderID returns the GSPAY2-assigned ID, once an event has reported it.
+func (m *Machine) ProviderID() string {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.providerID
+}
+
+// ExpiresAt returns the payment expire date, or the zero time if unknown.
+func (m *Machine) ExpiresAt() time.Time {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.expiresAt
+}
+
+// History returns the events that changed the state, oldest first.
+func (m *Machine) History() []Event {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return slices.Clone(m.history)
+}
+
+// pastExpiry reports whether the expire date is known and has passed at t.
+func (m *Machine) pastExpiry(t time.Time) bool {
+	return !m.expiresAt.IsZero() && !t.Before(m.expiresAt)
+}
@@ -0,0 +1,222 @@
+// Copyright 2026 H0llyW00dzZ
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lifecycle
+
+import (
+	"testing"
+	"time"
+
+	"github.com/H0llyW00dzZ/gspay-go-sdk/src/constants"
+	"github.com/H0llyW00dzZ/gspay-go-sdk/src/errors"
+	"github.com/H0llyW00dzZ/gspay-go-sdk/src/i18n"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFromStatus(t *testing.T) {
+	assert.Equal(t, StatePending, FromStatus(constants.StatusPending))
+	assert.Equal(t, StateSucceeded, FromStatus(constants.StatusSuccess))
+	assert.Equal(t, StateFailed, FromStatus(constants.StatusFailed))
+	assert.Equal(t, StateExpired, FromStatus(constants.StatusTimeout))
+	assert.Equal(t, StatePending, FromStatus(constants.PaymentStatus(9)))
+}
+
+func TestCanTransition(t *testing.T) {
+	t.Run("allows forward transitions", func(t *testing.T) {
+		assert.True(t, CanTransition(StateNew, StatePending))
+		assert.True(t, CanTransition(StateNew, StateSucceeded))
+		assert.True(t, CanTransition(StatePending, StateSucceeded))
+		assert.True(t, CanTransition(StatePending, StateExpired))
+		assert.True(t, CanTransition(StateExpired, StateSucceeded))
+	})
+
+	t.Run("allows repeating a state", func(t *testing.T) {
+		for _, s := range []State{StateNew, StatePending, StateSucceeded, StateFailed, StateExpired} {
+			assert.True(t, CanTransition(s, s), s)
+		}
+	})
+
+	t.Run("rejects leaving a final state", func(t *testing.T) {
+		assert.False(t, CanTransition(StateSucceeded, StatePending))
+		assert.False(t, CanTransition(StateSucceeded, StateFailed))
+		assert.False(t, CanTransition(StateFailed, StateSucceeded))
+		assert.False(t, CanTransition(StateExpired, StatePending))
+		assert.False(t, CanTransition(StatePending, StateNew))
+	})
+
+	assert.True(t, StateSucceeded.IsFinal())
+	assert.True(t, StateFailed.IsFinal())
+	assert.False(t, StateExpired.IsFinal())
+}
+
+func TestMachine_Apply(t *testing.T) {
+	base := time.Date(2026, 1, 26, 15, 0, 0, 0, time.UTC)
+	event := func(state State, source Source, offset time.Duration) Event {
+		return Event{Kind: KindPaymentIDR, State: state, TransactionID: "TXN123456789", At: base.Add(offset), Source: source}
+	}
+
+	t.Run("follows a successful payment", func(t *testing.T) {
+		m := New(KindPaymentIDR, "TXN123456789")
+		assert.Equal(t, StateNew, m.State())
+
+		created := event(StatePending, SourceCreate, 0)
+		created.ProviderID = "PAY123"
+		changed, err := m.Apply(created)
+		require.NoError(t, err)
+		assert.True(t, changed)
+
+		changed, err = m.Apply(event(StateSucceeded, SourceCallback, time.Minute))
+		require.NoError(t, err)
+		assert.True(t, changed)
+		assert.Equal(t, StateSucceeded, m.State())
+		assert.Equal(t, "PAY123", m.ProviderID())
+
+		// A duplicate callback is not a change.
+		changed, err = m.Apply(event(StateSucceeded, SourceCallback, 2*time.Minute))
+		require.NoError(t, err)
+		assert.False(t, changed)
+
+		history := m.History()
+		require.Len(t, history, 2)
+		assert.Equal(t, SourceCreate, history[0].Source)
+		assert.Equal(t, SourceCallback, history[1].Source)
+	})
+
+	t.Run("rejects illegal transitions", func(t *testing.T) {
+		m := New(KindPaymentIDR, "TXN123456789")
+		_, err := m.Apply(event(StateSucceeded, SourceCallback, 0))
+		require.NoError(t, err)
+
+		changed, err := m.Apply(event(StatePending, SourceStatus, time.Minute))
+		assert.False(t, changed)
+		assert.ErrorIs(t, err, errors.ErrIllegalTransition)
+		assert.Contains(t, err.Error(), "succeeded -> pending")
+		assert.Equal(t, StateSucceeded, m.State())
+		assert.Len(t, m.History(), 1)
+	})
+
+	t.Run("rejects events for another transaction", func(t *testing.T) {
+		m := New(KindPaymentIDR, "TXN123456789", WithLanguage(i18n.Indonesian))
+
+		other := event(StatePending, SourceStatus, 0)
+		other.TransactionID = "TXN999999999"
+		_, err := m.Apply(other)
+		assert.ErrorIs(t, err, errors.ErrEventMismatch)
+		assert.Contains(t, err.Error(), "transaksi lain")
+
+		other = event(StatePending, SourceStatus, 0)
+		other.Kind = KindPayoutIDR
+		_, err = m.Apply(other)
+		assert.ErrorIs(t, err, errors.ErrEventMismatch)
+		assert.Equal(t, StateNew, m.State())
+	})
+
+	t.Run("treats pending after the expire date as expired", func(t *testing.T) {
+		m := New(KindPaymentIDR, "TXN123456789")
+		created := event(StatePending, SourceCreate, 0)
+		created.ExpiresAt = base.Add(15 * time.Minute)
+		_, err := m.Apply(created)
+		require.NoError(t, err)
+		assert.Equal(t, base.Add(15*time.Minute), m.ExpiresAt())
+
+		_, err = m.Apply(event(StatePending, SourceStatus, 10*time.Minute))
+		require.NoError(t, err)
+		assert.Equal(t, StatePending, m.State())
+
+		changed, err := m.Apply(event(StatePending, SourceStatus, 20*time.Minute))
+		require.NoError(t, err)
+		assert.True(t, changed)
+		assert.Equal(t, StateExpired, m.State())
+
+		// Still reported as status 0 by GSPAY2.
+		changed, err = m.Apply(event(StatePending, SourceStatus, 30*time.Minute))
+		require.NoError(t, err)
+		assert.False(t, changed)
+
+		// A late settlement still wins.
+		changed, err = m.Apply(event(StateSucceeded, SourceCallback, 31*time.Minute))
+		require.NoError(t, err)
+		assert.True(t, changed)
+		assert.Equal(t, StateSucceeded, m.State())
+	})
+
+	t.Run("reports stale pending events after expiry", func(t *testing.T) {
+		var (
+			m       *Machine
+			ignored []Event
+		)
+		m = New(KindPaymentIDR, "TXN123456789", WithIgnoredHandler(func(ev Event) {
+			assert.Equal(t, StateExpired, m.State())
+			ignored = append(ignored, ev)
+		}))
+		_, err := m.Apply(event(StateExpired, SourceCallback, 20*time.Minute))
+		require.NoError(t, err)
+
+		stale := event(StatePending, SourceStatus, 10*time.Minute)
+		changed, err := m.Apply(stale)
+		require.NoError(t, err)
+		assert.False(t, changed)
+		assert.Equal(t, StateExpired, m.State())
+		require.Len(t, ignored, 1)
+		assert.Equal(t, SourceStatus, ignored[0].Source)
+	})
+
+	t.Run("timestamps events without a time", func(t *testing.T) {
+		m := New(KindPaymentIDR, "TXN123456789", WithClock(func() time.Time { return base }))
+		_, err := m.Apply(Event{Kind: KindPaymentIDR, State: StatePending})
+		require.NoError(t, err)
+		assert.Equal(t, base, m.History()[0].At)
+		assert.Equal(t, "TXN123456789", m.History()[0].TransactionID)
+	})
+}
+
+func TestMachine_Expire(t *testing.T) {
+	base := time.Date(2026, 1, 26, 15, 0, 0, 0, time.UTC)
+
+	t.Run("expires a pending transaction", func(t *testing.T) {
+		m := New(KindPaymentUSDT, "TXN123456789", WithExpiry(base))
+		_, err := m.Apply(Event{Kind: KindPaymentUSDT, State: StatePending, At: base.Add(-time.Minute)})
+		require.NoError(t, err)
+
+		_, ok := m.Expire(base.Add(-time.Second))
+		assert.False(t, ok)
+
+		ev, ok := m.Expire(base)
+		require.True(t, ok)
+		assert.Equal(t, StateExpired, ev.State)
+		assert.Equal(t, SourceExpiry, ev.Source)
+		assert.Equal(t, StateExpired, m.State())
+
+		_, ok = m.Expire(base.Add(time.Minute))
+		assert.False(t, ok)
+	})
+
+	t.Run("needs an expire date", func(t *testing.T) {
+		m := New(KindPaymentIDR, "TXN123456789")
+		_, err := m.Apply(Event{Kind: KindPaymentIDR, State: StatePending, At: base})
+		require.NoError(t, err)
+		_, ok := m.Expire(base.Add(time.Hour))
+		assert.False(t, ok)
+	})
+
+	t.Run("leaves final states alone", func(t *testing.T) {
+		m := New(KindPaymentIDR, "TXN123456789", WithExpiry(base))
+		_, err := m.Apply(Event{Kind: KindPaymentIDR, State: StateSucceeded, At: base.Add(-time.Minute)})
+		require.NoError(t, err)
+		_, ok := m.Expire(base.Add(time.Hour))
+		assert.False(t, ok)
+	})
+}
@@ -0,0 +1,104 @@
+// Copyright 2026 H0llyW00dzZ
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lifecycle
+
+import (
+	"github.com/H0llyW00dzZ/gspay-go-sdk/src/constants"
+	"github.com/H0llyW00dzZ/gspay-go-sdk/src/ledger"
+)
+
+// Kind identifies the type of transaction an [Event] belongs to. It is the
+// same type as [ledger.Kind], so events and ledger records share one set of
+// kinds.
+type Kind = ledger.Kind
+
+// Supported transaction kinds, defined by the ledger package.
+const (
+	// KindPaymentIDR is an IDR payment (see payment.IDRService).
+	KindPaymentIDR = ledger.KindPaymentIDR
+	// KindPaymentUSDT is a USDT payment (see payment.USDTService).
+	KindPaymentUSDT = ledger.KindPaymentUSDT
+	// KindPayoutIDR is an IDR payout (see payout.IDRService).
+	KindPayoutIDR = ledger.KindPayoutIDR
+)
+
+// State is the normalized state of a transaction.
+//
+// Unlike [constants.PaymentStatus], every state has a single meaning:
+// pending and expired are distinct, and so are failed and expired.
+type State string
+
+// Supported states.
+const (
+	// StateNew is the state of a [Machine] before its first event.
+	StateNew State = "new"
+	// StatePending means the transaction is waiting for the customer or the bank.
+	StatePending State = "pending"
+	// StateSucceeded means the transaction completed successfully. It is final.
+	StateSucceeded State = "succeeded"
+	// StateFailed means the transaction was rejected or failed. It is final.
+	StateFailed State = "failed"
+	// StateExpired means the payment window closed before the transaction
+	// completed. GSPAY2 may still settle an expired transaction late, so it
+	// can move to [StateSucceeded] or [StateFailed].
+	StateExpired State = "expired"
+)
+
+// transitions lists the states reachable from each state.
+// Repeating the current state is always allowed and is not listed.
+var transitions = map[State][]State{
+	StateNew:     {StatePending, StateSucceeded, StateFailed, StateExpired},
+	StatePending: {StateSucceeded, StateFailed, StateExpired},
+	StateExpired: {StateSucceeded, StateFailed},
+}
+
+// IsFinal reports whether no further transition is possible from s.
+func (s State) IsFinal() bool { return s == StateSucceeded || s == StateFailed }
+
+// CanTransition reports whether a transaction in state from may move to
+// state to. Staying in the same state is always allowed.
+func CanTransition(from, to State) bool {
+	if from == to {
+		return true
+	}
+	for _, s := range transitions[from] {
+		if s == to {
+			return true
+		}
+	}
+	return false
+}
+
+// FromStatus maps a GSPAY2 status code to a [State]:
+//
+//	0 (Pending)  -> StatePending
+//	1 (Success)  -> StateSucceeded
+//	2 (Failed)   -> StateFailed
+//	4 (Timeout)  -> StateExpired
+//
+// Unknown codes are treated as still pending. Status 0 is also sent for
+// expired payments; a [Machine] tells the two apart using the expire date.
+func FromStatus(status constants.PaymentStatus) State {
+	switch status {
+	case constants.StatusSuccess:
+		return StateSucceeded
+	case constants.StatusFailed:
+		return StateFailed
+	case constants.StatusTimeout:
+		return StateExpired
+	default:
+		return StatePending
+	}
+}
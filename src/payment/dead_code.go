@@ -44,22 +44,24 @@ func (s *USDTService) verifyCallbackSignature(callback *USDTCallback) error {
 	// Format amount with 2 decimal places
 	amount, err := strconv.ParseFloat(callback.Amount, 64)
 	if err != nil {
-		return errors.NewValidationError(lang, "amount", errors.GetMessage(lang, errors.KeyInvalidAmountFormat))
+		return errors.NewValidationErrorKey(lang, "amount", errors.KeyInvalidAmountFormat)
 	}
 	formattedAmount := fmt.Sprintf("%.2f", amount)
 
-	// Generate expected signature
-	signatureData := fmt.Sprintf("%s%s%s%d%s",
+	// Build signature data (each accepted secret key is appended when matching)
+	signatureData := fmt.Sprintf("%s%s%s%d",
 		callback.CryptoPaymentID,
 		formattedAmount,
 		callback.TransactionID,
 		callback.Status,
-		s.client.SecretKey,
 	)
-	expectedSignature := s.client.GenerateSignature(signatureData)
 
-	// Constant-time comparison to prevent timing attacks
-	if !s.client.VerifySignature(expectedSignature, callback.Signature) {
+	// Constant-time comparison against every accepted secret key
+	_, ok, err := s.client.MatchSignature(signatureData, callback.Signature)
+	if err != nil {
+		return err
+	}
+	if !ok {
 		return errors.New(lang, errors.ErrInvalidSignature)
 	}
 
@@ -31,7 +31,11 @@
 //	    Channel:       constants.ChannelQRIS,
 //	})
 //
-// Available channels: QRIS, DANA, BNI Virtual Account.
+// Available channels: QRIS, DANA, BNI Virtual Account, plus any channel
+// registered with constants.RegisterChannel. Unknown channels are dropped
+// unless the service is created with [WithStrictChannels]:
+//
+//	paymentSvc := payment.NewIDRService(c, payment.WithStrictChannels())
 //
 // # USDT Payment Service
 //
@@ -48,6 +52,16 @@
 // Note: USDT payments are not supported for Indonesian merchants
 // due to government regulations.
 //
+// # Expiry
+//
+// Create responses keep ExpireDate as sent by GSPAY2. ExpiresAt parses it in
+// the client's server location (see [client.WithServerLocation]):
+//
+//	if resp.IsExpired(time.Now()) {
+//	    // Create a new payment
+//	}
+//	fmt.Println("Pay within", resp.TimeRemaining())
+//
 // # Callback Verification
 //
 // Verify webhook callbacks with signature validation:
@@ -62,6 +76,15 @@
 //	    // Unauthorized IP or invalid signature
 //	}
 //
+// VerifyCallbackRequest reads, decodes and verifies a callback request in one
+// step, so handlers do not need to decode with json.Decoder.UseNumber
+// themselves. VerifyCallbackBytes does the same for a raw body:
+//
+//	callback, err := paymentSvc.VerifyCallbackRequest(r)
+//	if err != nil {
+//	    // Oversized body, invalid JSON, unauthorized IP or invalid signature
+//	}
+//
 // # Payment Status
 //
 // Check payment status using:
@@ -0,0 +1,38 @@
+// Copyright 2026 H0llyW00dzZ
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package payment
+
+import (
+	"time"
+
+	"github.com/H0llyW00dzZ/gspay-go-sdk/src/helper/expiry"
+	"github.com/H0llyW00dzZ/gspay-go-sdk/src/i18n"
+)
+
+// expiryContext carries what a response needs to interpret its expire date.
+// The zero value uses the default server location and English messages.
+type expiryContext struct {
+	loc  *time.Location
+	lang i18n.Language
+}
+
+// parse parses an expire date sent by GSPAY2.
+func (e expiryContext) parse(value string) (time.Time, error) {
+	lang := e.lang
+	if lang == "" {
+		lang = i18n.English
+	}
+	return expiry.Parse(value, e.loc, lang)
+}
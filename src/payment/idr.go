@@ -18,13 +18,16 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"strings"
+	"net/http"
+	"time"
 
 	"github.com/H0llyW00dzZ/gspay-go-sdk/src/client"
 	"github.com/H0llyW00dzZ/gspay-go-sdk/src/constants"
 	"github.com/H0llyW00dzZ/gspay-go-sdk/src/errors"
 	amountfmt "github.com/H0llyW00dzZ/gspay-go-sdk/src/helper/amount"
+	"github.com/H0llyW00dzZ/gspay-go-sdk/src/helper/expiry"
 	"github.com/H0llyW00dzZ/gspay-go-sdk/src/i18n"
+	"github.com/H0llyW00dzZ/gspay-go-sdk/src/internal/validate"
 )
 
 // IDRRequest represents a request to create an IDR payment.
@@ -35,11 +38,41 @@ type IDRRequest struct {
 	Username string `json:"player_username"`
 	// Amount is the payment amount in IDR (no decimals, e.g., 10000).
 	Amount int64 `json:"amount"`
-	// Channel is an optional payment channel (QRIS, DANA, BNI).
+	// Channel is an optional payment channel (QRIS, DANA, BNI, or any channel
+	// registered with constants.RegisterChannel).
 	// If omitted, user will select on the payment page.
 	Channel constants.ChannelIDR `json:"channel,omitempty"`
 }
 
+// Validate checks every field of the request and returns all failures as
+// an [errors.ValidationErrors] with English messages. It returns nil if the
+// request is valid. Use [IDRRequest.ValidateLang] for another language.
+func (r *IDRRequest) Validate() error {
+	return r.ValidateLang(i18n.English)
+}
+
+// ValidateLang is like [IDRRequest.Validate] but localizes the messages in lang.
+// Create runs the same checks in the client language.
+func (r *IDRRequest) ValidateLang(lang i18n.Language) error {
+	if errs := r.check(lang); len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+// check checks every field of the request, localized in lang.
+func (r *IDRRequest) check(lang i18n.Language) errors.ValidationErrors {
+	var errs errors.ValidationErrors
+	validate.TransactionID(&errs, lang, r.TransactionID)
+	validate.Username(&errs, lang, r.Username)
+
+	// Minimum 10000 IDR
+	if r.Amount < constants.MinAmountIDR {
+		errs.AddKey(lang, "amount", errors.ErrInvalidAmount, errors.KeyMinAmountIDR)
+	}
+	return errs
+}
+
 // idrAPIRequest is the internal API request structure.
 type idrAPIRequest struct {
 	TransactionID string `json:"transaction_id"`
@@ -57,7 +90,8 @@ type IDRResponse struct {
 	TransactionID string `json:"transaction_id"`
 	// Amount is the payment amount.
 	Amount string `json:"amount"`
-	// ExpireDate is the payment expiration date/time.
+	// ExpireDate is the payment expiration date/time as sent by GSPAY2.
+	// Use [IDRResponse.ExpiresAt] for the parsed time.
 	ExpireDate string `json:"expire_date"`
 	// Status is the initial payment status.
 	Status string `json:"status"`
@@ -65,6 +99,40 @@ type IDRResponse struct {
 	PaymentURL string `json:"payment_url"`
 	// QR is the QR code string for payment.
 	QR string `json:"qr,omitempty"`
+
+	// expiry interprets ExpireDate; set by Create, zero otherwise.
+	expiry expiryContext
+}
+
+// ExpiresAt parses ExpireDate in the client's server location
+// (see [client.WithServerLocation]). An unknown format returns an error
+// wrapping [errors.ErrInvalidExpireDate].
+//
+// The location is recorded by Create. On a response built or decoded by the
+// caller, ExpireDate is read in [constants.DefaultServerLocation] (WIB) and
+// errors are in English; use [expiry.Parse] to choose the location.
+func (r *IDRResponse) ExpiresAt() (time.Time, error) {
+	return r.expiry.parse(r.ExpireDate)
+}
+
+// TimeRemaining returns the time left until the payment expires, or zero if
+// it has expired or ExpireDate cannot be parsed.
+func (r *IDRResponse) TimeRemaining() time.Duration {
+	t, err := r.ExpiresAt()
+	if err != nil {
+		return 0
+	}
+	return expiry.Remaining(t, time.Now())
+}
+
+// IsExpired reports whether the payment has expired at now. It returns false
+// if ExpireDate cannot be parsed; call [IDRResponse.ExpiresAt] to get the error.
+func (r *IDRResponse) IsExpired(now time.Time) bool {
+	t, err := r.ExpiresAt()
+	if err != nil {
+		return false
+	}
+	return expiry.IsExpired(t, now)
 }
 
 // IDRStatusResponse represents the response from querying IDR payment status.
@@ -116,33 +184,47 @@ type IDRCallback struct {
 }
 
 // IDRService handles IDR payment operations.
-type IDRService struct{ client *client.Client }
+type IDRService struct {
+	client         *client.Client
+	strictChannels bool
+}
 
 // NewIDRService creates a new IDR payment service.
-func NewIDRService(c *client.Client) *IDRService { return &IDRService{client: c} }
+func NewIDRService(c *client.Client, opts ...Option) *IDRService {
+	s := &IDRService{client: c}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
 
 // Create creates a new IDR payment order.
 //
-// The generated order expires after approximately 15 minutes.
+// The generated order expires after approximately 15 minutes (see
+// constants.Channel.Expiry). An unregistered channel is dropped unless the
+// service was created with [WithStrictChannels].
 //
 // Signature formula: MD5(transaction_id + player_username + amount + operator_secret_key)
-func (s *IDRService) Create(ctx context.Context, req *IDRRequest) (*IDRResponse, error) {
-	s.client.Logger().Info(s.client.I18n(i18n.LogCreatingIDRPayment),
+func (s *IDRService) Create(ctx context.Context, req *IDRRequest, opts ...client.CallOption) (*IDRResponse, error) {
+	c := s.client.WithCallOptions(opts...)
+	c.Logger().Info(c.I18n(i18n.LogCreatingIDRPayment),
 		"transactionID", req.TransactionID,
 		"username", req.Username,
 		"amount", req.Amount,
 		"channel", req.Channel,
 	)
 
-	// Validate transaction ID length
-	if len(req.TransactionID) < constants.MinTransactionIDLength ||
-		len(req.TransactionID) > constants.MaxTransactionIDLength {
-		return nil, errors.NewValidationError(s.client.Language, "transaction_id", s.client.I18n(errors.MsgInvalidTransactionID))
+	errs := req.check(c.Language)
+	if s.strictChannels && req.Channel != "" {
+		validate.Channel(&errs, c.Language, req.Channel, req.Amount)
+	}
+	if len(errs) > 0 {
+		return nil, errs
 	}
 
-	// Validate amount (minimum 10000 IDR)
-	if req.Amount < constants.MinAmountIDR {
-		return nil, errors.NewValidationError(s.client.Language, "amount", s.client.I18n(errors.KeyMinAmountIDR))
+	c, err := c.Resolve(ctx)
+	if err != nil {
+		return nil, err
 	}
 
 	// Generate signature: transaction_id + player_username + amount + secret_key
@@ -150,9 +232,9 @@ func (s *IDRService) Create(ctx context.Context, req *IDRRequest) (*IDRResponse,
 		req.TransactionID,
 		req.Username,
 		req.Amount,
-		s.client.SecretKey,
+		c.SigningSecret(),
 	)
-	sig := s.client.GenerateSignature(signatureData)
+	sig := c.GenerateSignature(signatureData)
 
 	// Build API request
 	apiReq := idrAPIRequest{
@@ -162,27 +244,31 @@ func (s *IDRService) Create(ctx context.Context, req *IDRRequest) (*IDRResponse,
 		Signature:     sig,
 	}
 
-	// Add channel if specified
+	// Add channel if specified, normalized to uppercase (e.g., "qris" -> "QRIS")
 	if req.Channel != "" {
-		// Normalize to uppercase (e.g., "qris" -> "QRIS")
-		upperChannel := constants.ChannelIDR(strings.ToUpper(string(req.Channel)))
-		if constants.IsValidChannelIDR(upperChannel) {
-			apiReq.Channel = string(upperChannel)
+		if ch, ok := constants.LookupChannel(req.Channel); ok {
+			apiReq.Channel = string(ch.Code)
+		} else {
+			c.Logger().Warn(c.I18n(i18n.LogIDRChannelDropped), "channel", req.Channel)
 		}
 	}
 
-	endpoint := fmt.Sprintf(constants.GetEndpoint(constants.EndpointIDRCreate), s.client.AuthKey)
-	resp, err := s.client.Post(ctx, endpoint, apiReq)
+	endpoint := fmt.Sprintf(constants.GetEndpoint(constants.EndpointIDRCreate), c.AuthKey)
+	resp, err := c.Post(ctx, endpoint, apiReq)
 	if err != nil {
 		return nil, err
 	}
 
-	result, err := client.ParseData[IDRResponse](resp.Data, s.client.Language)
+	result, err := client.ParseData[IDRResponse](resp.Data, c.Language)
 	if err != nil {
 		return nil, err
 	}
+	if result == nil {
+		return nil, c.Error(errors.ErrEmptyResponse)
+	}
+	result.expiry = expiryContext{loc: c.ServerLocation(), lang: c.Language}
 
-	s.client.Logger().Info(s.client.I18n(i18n.LogIDRPaymentCreated),
+	c.Logger().Info(c.I18n(i18n.LogIDRPaymentCreated),
 		"transactionID", result.TransactionID,
 		"paymentID", result.IDRPaymentID,
 		"status", result.Status,
@@ -192,23 +278,32 @@ func (s *IDRService) Create(ctx context.Context, req *IDRRequest) (*IDRResponse,
 }
 
 // GetStatus retrieves the current status of an IDR payment order.
-func (s *IDRService) GetStatus(ctx context.Context, transactionID string) (*IDRStatusResponse, error) {
-	s.client.Logger().Debug(s.client.I18n(i18n.LogQueryingIDRPaymentStatus), "transactionID", transactionID)
+func (s *IDRService) GetStatus(ctx context.Context, transactionID string, opts ...client.CallOption) (*IDRStatusResponse, error) {
+	c := s.client.WithCallOptions(opts...)
+	c.Logger().Debug(c.I18n(i18n.LogQueryingIDRPaymentStatus), "transactionID", transactionID)
 
-	endpoint := fmt.Sprintf(constants.GetEndpoint(constants.EndpointIDRStatus), s.client.AuthKey)
-	resp, err := s.client.Get(ctx, endpoint, map[string]string{
+	c, err := c.Resolve(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	endpoint := fmt.Sprintf(constants.GetEndpoint(constants.EndpointIDRStatus), c.AuthKey)
+	resp, err := c.Get(ctx, endpoint, map[string]string{
 		"transaction_id": transactionID,
 	})
 	if err != nil {
 		return nil, err
 	}
 
-	result, err := client.ParseData[IDRStatusResponse](resp.Data, s.client.Language)
+	result, err := client.ParseData[IDRStatusResponse](resp.Data, c.Language)
 	if err != nil {
 		return nil, err
 	}
+	if result == nil {
+		return nil, c.Error(errors.ErrEmptyResponse)
+	}
 
-	s.client.Logger().Info(s.client.I18n(i18n.LogIDRPaymentStatusRetrieved),
+	c.Logger().Info(c.I18n(i18n.LogIDRPaymentStatusRetrieved),
 		"transactionID", result.TransactionID,
 		"status", result.Status,
 		"paymentID", result.IDRPaymentID,
@@ -224,8 +319,9 @@ func (s *IDRService) GetStatus(ctx context.Context, transactionID string) (*IDRS
 //
 // Formula: MD5(id + amount + transaction_id + status + operator_secret_key)
 // Note: Amount should be formatted with 2 decimal places (e.g., "10000.00").
-func (s *IDRService) VerifySignature(id, amount, transactionID string, status constants.PaymentStatus, receivedSignature string) error {
-	s.client.Logger().Debug(s.client.I18n(i18n.LogVerifyingIDRSignature),
+func (s *IDRService) VerifySignature(id, amount, transactionID string, status constants.PaymentStatus, receivedSignature string, opts ...client.CallOption) error {
+	c := s.client.WithCallOptions(opts...)
+	c.Logger().Debug(c.I18n(i18n.LogVerifyingIDRSignature),
 		"paymentID", id,
 		"transactionID", transactionID,
 		"amount", amount,
@@ -234,52 +330,54 @@ func (s *IDRService) VerifySignature(id, amount, transactionID string, status co
 
 	// Check required fields
 	if id == "" {
-		s.client.Logger().Warn(s.client.I18n(i18n.LogIDRSigVerifyFailedMissing), "field", "id")
-		return s.client.Error(errors.ErrMissingCallbackField, "id")
+		c.Logger().Warn(c.I18n(i18n.LogIDRSigVerifyFailedMissing), "field", "id")
+		return c.Error(errors.ErrMissingCallbackField, "id")
 	}
 	if amount == "" {
-		s.client.Logger().Warn(s.client.I18n(i18n.LogIDRSigVerifyFailedMissing), "field", "amount")
-		return s.client.Error(errors.ErrMissingCallbackField, "amount")
+		c.Logger().Warn(c.I18n(i18n.LogIDRSigVerifyFailedMissing), "field", "amount")
+		return c.Error(errors.ErrMissingCallbackField, "amount")
 	}
 	if transactionID == "" {
-		s.client.Logger().Warn(s.client.I18n(i18n.LogIDRSigVerifyFailedMissing), "field", "transaction_id")
-		return s.client.Error(errors.ErrMissingCallbackField, "transaction_id")
+		c.Logger().Warn(c.I18n(i18n.LogIDRSigVerifyFailedMissing), "field", "transaction_id")
+		return c.Error(errors.ErrMissingCallbackField, "transaction_id")
 	}
 	if receivedSignature == "" {
-		s.client.Logger().Warn(s.client.I18n(i18n.LogIDRSigVerifyFailedMissing), "field", "signature")
-		return s.client.Error(errors.ErrMissingCallbackField, "signature")
+		c.Logger().Warn(c.I18n(i18n.LogIDRSigVerifyFailedMissing), "field", "signature")
+		return c.Error(errors.ErrMissingCallbackField, "signature")
 	}
 
 	// Format amount with 2 decimal places
-	formattedAmount, err := amountfmt.Format(amount, s.client.Language)
+	formattedAmount, err := amountfmt.Format(amount, c.Language)
 	if err != nil {
-		s.client.Logger().Warn(s.client.I18n(i18n.LogIDRSigVerifyFailedFormat),
+		c.Logger().Warn(c.I18n(i18n.LogIDRSigVerifyFailedFormat),
 			"amount", amount,
 			"error", err.Error(),
 		)
 		return err
 	}
 
-	// Generate expected signature
-	signatureData := fmt.Sprintf("%s%s%s%d%s",
+	// Build signature data (each accepted secret key is appended when matching)
+	signatureData := fmt.Sprintf("%s%s%s%d",
 		id,
 		formattedAmount,
 		transactionID,
 		int(status),
-		s.client.SecretKey,
 	)
-	expectedSignature := s.client.GenerateSignature(signatureData)
 
-	// Constant-time comparison to prevent timing attacks
-	if !s.client.VerifySignature(expectedSignature, receivedSignature) {
-		s.client.Logger().Warn(s.client.I18n(i18n.LogIDRSigVerifyFailedMismatch),
+	// Constant-time comparison against every accepted secret key
+	_, ok, err := c.MatchSignature(signatureData, receivedSignature)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		c.Logger().Warn(c.I18n(i18n.LogIDRSigVerifyFailedMismatch),
 			"paymentID", id,
 			"transactionID", transactionID,
 		)
-		return s.client.Error(errors.ErrInvalidSignature)
+		return c.Error(errors.ErrInvalidSignature)
 	}
 
-	s.client.Logger().Debug(s.client.I18n(i18n.LogIDRSignatureVerified),
+	c.Logger().Debug(c.I18n(i18n.LogIDRSignatureVerified),
 		"paymentID", id,
 		"transactionID", transactionID,
 	)
@@ -292,8 +390,9 @@ func (s *IDRService) VerifySignature(id, amount, transactionID string, status co
 // Note: Amount in status response has 2 decimal places (e.g., "10000.00").
 //
 // This method verifies the signature included in the status response.
-func (s *IDRService) VerifyStatusSignature(status *IDRStatusResponse) error {
-	s.client.Logger().Debug(s.client.I18n(i18n.LogVerifyingIDRStatusSig),
+func (s *IDRService) VerifyStatusSignature(status *IDRStatusResponse, opts ...client.CallOption) error {
+	c := s.client.WithCallOptions(opts...)
+	c.Logger().Debug(c.I18n(i18n.LogVerifyingIDRStatusSig),
 		"paymentID", status.IDRPaymentID,
 		"transactionID", status.TransactionID,
 		"status", status.Status,
@@ -305,11 +404,12 @@ func (s *IDRService) VerifyStatusSignature(status *IDRStatusResponse) error {
 		status.TransactionID,
 		status.Status,
 		status.Signature,
+		opts...,
 	); err != nil {
 		return err
 	}
 
-	s.client.Logger().Info(s.client.I18n(i18n.LogIDRStatusSigVerified),
+	c.Logger().Info(c.I18n(i18n.LogIDRStatusSigVerified),
 		"paymentID", status.IDRPaymentID,
 		"transactionID", status.TransactionID,
 	)
@@ -323,7 +423,7 @@ func (s *IDRService) VerifyStatusSignature(status *IDRStatusResponse) error {
 //
 // This method only verifies the signature. To also verify the source IP,
 // use [IDRService.VerifyCallbackWithIP] instead.
-func (s *IDRService) VerifyCallback(callback *IDRCallback) error {
+func (s *IDRService) VerifyCallback(callback *IDRCallback, opts ...client.CallOption) error {
 	// Delegate to VerifySignature which handles all logging
 	return s.VerifySignature(
 		string(callback.IDRPaymentID),
@@ -331,6 +431,7 @@ func (s *IDRService) VerifyCallback(callback *IDRCallback) error {
 		callback.TransactionID,
 		callback.Status,
 		callback.Signature,
+		opts...,
 	)
 }
 
@@ -351,16 +452,17 @@ func (s *IDRService) VerifyCallback(callback *IDRCallback) error {
 //	        // Handle error
 //	    }
 //	}
-func (s *IDRService) VerifyCallbackWithIP(callback *IDRCallback, sourceIP string) error {
-	s.client.Logger().Debug(s.client.I18n(i18n.LogVerifyingIDRCallback),
+func (s *IDRService) VerifyCallbackWithIP(callback *IDRCallback, sourceIP string, opts ...client.CallOption) error {
+	c := s.client.WithCallOptions(opts...)
+	c.Logger().Debug(c.I18n(i18n.LogVerifyingIDRCallback),
 		"transactionID", callback.TransactionID,
 		"paymentID", callback.IDRPaymentID,
 		"sourceIP", sourceIP,
 	)
 
 	// Verify IP first (fast fail)
-	if err := s.client.VerifyCallbackIP(sourceIP); err != nil {
-		s.client.Logger().Warn(s.client.I18n(i18n.LogIDRCallbackIPFailed),
+	if err := c.VerifyCallbackIP(sourceIP); err != nil {
+		c.Logger().Warn(c.I18n(i18n.LogIDRCallbackIPFailed),
 			"sourceIP", sourceIP,
 			"error", err.Error(),
 		)
@@ -368,14 +470,78 @@ func (s *IDRService) VerifyCallbackWithIP(callback *IDRCallback, sourceIP string
 	}
 
 	// Then verify signature (VerifySignature handles failure logging)
-	if err := s.VerifyCallback(callback); err != nil {
+	if err := s.VerifyCallback(callback, opts...); err != nil {
 		return err
 	}
 
-	s.client.Logger().Info(s.client.I18n(i18n.LogIDRCallbackVerified),
+	c.Logger().Info(c.I18n(i18n.LogIDRCallbackVerified),
 		"transactionID", callback.TransactionID,
 		"paymentID", callback.IDRPaymentID,
 		"status", callback.Status,
 	)
 	return nil
 }
+
+// VerifyCallbackBytes decodes a raw IDR payment callback body and verifies it.
+//
+// The body is decoded with [client.Client.DecodeCallback], so numbers keep
+// the exact form GSPAY2 signed and the size and strictness settings of the
+// client apply. If [client.WithCallbackSourceIP] is given, the source IP is
+// verified as in [IDRService.VerifyCallbackWithIP]; otherwise only the signature
+// is verified.
+//
+// Example:
+//
+//	callback, err := svc.VerifyCallbackBytes(body, client.WithCallbackSourceIP(ip))
+//	if err != nil {
+//	    // Reject the callback
+//	}
+func (s *IDRService) VerifyCallbackBytes(body []byte, opts ...client.CallOption) (*IDRCallback, error) {
+	c := s.client.WithCallOptions(opts...)
+	var callback IDRCallback
+	if err := c.DecodeCallback(body, &callback); err != nil {
+		return nil, err
+	}
+
+	var err error
+	if sourceIP, ok := c.CallbackSourceIP(); ok {
+		err = s.VerifyCallbackWithIP(&callback, sourceIP, opts...)
+	} else {
+		err = s.VerifyCallback(&callback, opts...)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &callback, nil
+}
+
+// VerifyCallbackRequest reads, decodes and verifies an IDR payment callback
+// request in one step: body size, JSON, source IP and signature.
+//
+// The source IP is [http.Request.RemoteAddr]. Behind a reverse proxy, pass
+// the client IP it resolved with [client.WithCallbackSourceIP].
+//
+// Example:
+//
+//	http.HandleFunc("POST /callback", func(w http.ResponseWriter, r *http.Request) {
+//	    callback, err := svc.VerifyCallbackRequest(r)
+//	    if err != nil {
+//	        http.Error(w, "invalid callback", http.StatusBadRequest)
+//	        return
+//	    }
+//	    // Process callback
+//	    w.Write([]byte("OK"))
+//	})
+func (s *IDRService) VerifyCallbackRequest(r *http.Request, opts ...client.CallOption) (*IDRCallback, error) {
+	body, err := s.client.WithCallOptions(opts...).ReadCallback(r)
+	if err != nil {
+		return nil, err
+	}
+	return s.VerifyCallbackBytes(body, append([]client.CallOption{client.WithCallbackSourceIP(r.RemoteAddr)}, opts...)...)
+}
+
+// MaxCallbackSize returns the largest IDR payment callback body the service
+// accepts, set by [client.WithMaxCallbackSize].
+func (s *IDRService) MaxCallbackSize() int64 {
+	return s.client.MaxCallbackSize()
+}
@@ -22,10 +22,12 @@ import (
 	"strconv"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/H0llyW00dzZ/gspay-go-sdk/src/client"
 	"github.com/H0llyW00dzZ/gspay-go-sdk/src/constants"
 	"github.com/H0llyW00dzZ/gspay-go-sdk/src/errors"
+	"github.com/H0llyW00dzZ/gspay-go-sdk/src/i18n"
 	"github.com/H0llyW00dzZ/gspay-go-sdk/src/internal/signature"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -80,6 +82,34 @@ func TestIDRService_Create(t *testing.T) {
 		assert.Equal(t, "PAY123", resp.IDRPaymentID)
 	})
 
+	t.Run("parses expire date in server location", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			json.NewEncoder(w).Encode(map[string]any{
+				"code":    200,
+				"message": "success",
+				"data":    `{"idrpayment_id":"PAY123","transaction_id":"TXN123456789","amount":"50000","expire_date":"2026-01-26 15:00:00","status":"0","payment_url":"https://pay.example.com"}`,
+			})
+		}))
+		defer server.Close()
+
+		c := client.New("auth-key", "secret-key", client.WithBaseURL(server.URL), client.WithServerLocation(time.UTC))
+		resp, err := NewIDRService(c).Create(t.Context(), &IDRRequest{
+			TransactionID: "TXN123456789",
+			Username:      "user123",
+			Amount:        50000,
+		})
+		require.NoError(t, err)
+
+		expiresAt, err := resp.ExpiresAt()
+		require.NoError(t, err)
+		assert.Equal(t, time.Date(2026, 1, 26, 15, 0, 0, 0, time.UTC), expiresAt)
+		assert.False(t, resp.IsExpired(expiresAt.Add(-time.Second)))
+		assert.True(t, resp.IsExpired(expiresAt))
+		// The fixture date is in the past.
+		assert.Zero(t, resp.TimeRemaining())
+	})
+
 	t.Run("validates transaction ID length", func(t *testing.T) {
 		c := client.New("auth-key", "secret-key")
 		svc := NewIDRService(c)
@@ -202,6 +232,123 @@ func TestIDRService_Create(t *testing.T) {
 
 		require.NoError(t, err)
 	})
+
+	t.Run("returns error on missing data", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			json.NewEncoder(w).Encode(map[string]any{
+				"code":    200,
+				"message": "success",
+			})
+		}))
+		defer server.Close()
+
+		c := client.New("auth-key", "secret-key", client.WithBaseURL(server.URL))
+		svc := NewIDRService(c)
+
+		resp, err := svc.Create(t.Context(), &IDRRequest{
+			TransactionID: "TXN123456789",
+			Username:      "user123",
+			Amount:        50000,
+		})
+		assert.Nil(t, resp)
+		assert.ErrorIs(t, err, errors.ErrEmptyResponse)
+	})
+}
+
+func TestIDRService_StrictChannels(t *testing.T) {
+	var sent string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req idrAPIRequest
+		json.NewDecoder(r.Body).Decode(&req)
+		sent = req.Channel
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]any{
+			"code":    200,
+			"message": "success",
+			"data":    `{"idrpayment_id":"PAY123","transaction_id":"TXN123456789","amount":"50000","expire_date":"2026-01-26 15:00:00","status":"0","payment_url":"https://pay.example.com"}`,
+		})
+	}))
+	defer server.Close()
+
+	svc := NewIDRService(client.New("auth-key", "secret-key", client.WithBaseURL(server.URL)), WithStrictChannels())
+	create := func(channel constants.ChannelIDR, amount int64) error {
+		sent = ""
+		_, err := svc.Create(t.Context(), &IDRRequest{
+			TransactionID: "TXN123456789",
+			Username:      "user123",
+			Amount:        amount,
+			Channel:       channel,
+		})
+		return err
+	}
+
+	t.Run("rejects unknown channel", func(t *testing.T) {
+		err := create("INVALID_CHANNEL", 50000)
+		require.ErrorIs(t, err, errors.ErrInvalidChannel)
+		assert.Equal(t, "channel", errors.GetValidationError(err).Field)
+		assert.Empty(t, sent)
+	})
+
+	t.Run("rejects amount above channel limit", func(t *testing.T) {
+		err := create(constants.ChannelQRIS, 50_000_000)
+		require.ErrorIs(t, err, errors.ErrInvalidAmount)
+		assert.Equal(t, "must be between 10000 and 10000000 for QRIS", errors.GetValidationError(err).Message)
+	})
+
+	t.Run("accepts known channel", func(t *testing.T) {
+		require.NoError(t, create("qris", 50000))
+		assert.Equal(t, "QRIS", sent)
+	})
+
+	t.Run("accepts registered channel", func(t *testing.T) {
+		constants.RegisterChannel(constants.Channel{Code: "ovo", Name: "OVO", Type: constants.ChannelTypeEWallet, PaymentURL: true})
+		t.Cleanup(func() { constants.UnregisterChannel("OVO") })
+
+		require.NoError(t, create("ovo", 50000))
+		assert.Equal(t, "OVO", sent)
+	})
+
+	t.Run("no channel is always allowed", func(t *testing.T) {
+		require.NoError(t, create("", 50000))
+		assert.Empty(t, sent)
+	})
+}
+
+func TestIDRRequest_Validate(t *testing.T) {
+	t.Run("valid request", func(t *testing.T) {
+		req := &IDRRequest{TransactionID: "TXN-123_456", Username: "user123", Amount: 50000}
+		assert.NoError(t, req.Validate())
+	})
+
+	t.Run("reports every failing field", func(t *testing.T) {
+		err := (&IDRRequest{TransactionID: "TXN#123456", Amount: 10}).Validate()
+		errs := errors.GetValidationErrors(err)
+		require.Len(t, errs, 3)
+		assert.Equal(t, "transaction_id", errs[0].Field)
+		assert.Equal(t, i18n.Get(i18n.English, i18n.MsgTransactionIDChars), errs[0].Message)
+	})
+
+	t.Run("validate lang localizes every failing field", func(t *testing.T) {
+		errs := errors.GetValidationErrors((&IDRRequest{TransactionID: "TXN#123456", Amount: 10}).ValidateLang(i18n.Indonesian))
+		require.Len(t, errs, 3)
+		assert.Equal(t, i18n.Get(i18n.Indonesian, i18n.MsgTransactionIDChars), errs[0].Message)
+		assert.Equal(t, i18n.Get(i18n.Indonesian, i18n.MsgMinAmountIDR), errs[2].Message)
+	})
+
+	t.Run("create localizes every failing field", func(t *testing.T) {
+		svc := NewIDRService(client.New("auth-key", "secret-key", client.WithLanguage(i18n.Indonesian)))
+		_, err := svc.Create(t.Context(), &IDRRequest{TransactionID: "TXN#123456", Amount: 10})
+		errs := errors.GetValidationErrors(err)
+		require.Len(t, errs, 3)
+		assert.Equal(t, "transaction_id", errs[0].Field)
+		assert.Equal(t, i18n.Get(i18n.Indonesian, i18n.MsgTransactionIDChars), errs[0].Message)
+		assert.Equal(t, "username", errs[1].Field)
+		assert.Equal(t, "amount", errs[2].Field)
+		assert.ErrorIs(t, errs, errors.ErrInvalidUsername)
+		assert.ErrorIs(t, errs, errors.ErrInvalidAmount)
+	})
 }
 
 func TestIDRService_GetStatus(t *testing.T) {
@@ -236,6 +383,52 @@ func TestIDRService_GetStatus(t *testing.T) {
 		assert.Equal(t, "success", resp.Remark)
 		assert.Equal(t, "sig", resp.Signature)
 	})
+
+	t.Run("returns error on missing data", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			json.NewEncoder(w).Encode(map[string]any{
+				"code":    200,
+				"message": "success",
+			})
+		}))
+		defer server.Close()
+
+		c := client.New("auth-key", "secret-key", client.WithBaseURL(server.URL))
+		svc := NewIDRService(c)
+
+		resp, err := svc.GetStatus(t.Context(), "TXN123456789")
+		assert.Nil(t, resp)
+		assert.ErrorIs(t, err, errors.ErrEmptyResponse)
+	})
+
+	t.Run("applies call options", func(t *testing.T) {
+		var calls int
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			calls++
+			assert.Equal(t, "poll-1", r.Header.Get("X-Request-ID"))
+			w.Header().Set("Content-Type", "application/json")
+			json.NewEncoder(w).Encode(map[string]any{
+				"code":    200,
+				"message": "success",
+			})
+		}))
+		defer server.Close()
+
+		c := client.New("auth-key", "secret-key", client.WithBaseURL(server.URL))
+		svc := NewIDRService(c)
+
+		_, err := svc.GetStatus(t.Context(), "TXN123456789",
+			client.WithCallTimeout(2*time.Second),
+			client.WithCallRetries(0),
+			client.WithCallLanguage(i18n.Indonesian),
+			client.WithCallHeader("X-Request-ID", "poll-1"),
+		)
+		assert.ErrorIs(t, err, errors.ErrEmptyResponse)
+		assert.Contains(t, err.Error(), i18n.Get(i18n.Indonesian, i18n.MsgEmptyResponse))
+		assert.Equal(t, 1, calls)
+		assert.Equal(t, i18n.English, c.Language)
+	})
 }
 
 func TestIDRService_VerifyStatusSignature(t *testing.T) {
@@ -312,6 +505,33 @@ func TestIDRService_VerifyCallback(t *testing.T) {
 		assert.ErrorIs(t, err, errors.ErrInvalidSignature)
 	})
 
+	t.Run("accepts callbacks signed with the previous secret key", func(t *testing.T) {
+		secrets := client.NewRotatingSecrets(client.Secret{ID: "v1", Value: "old-secret"})
+		rotating := NewIDRService(client.New("auth-key", "old-secret", client.WithSecretProvider(secrets)))
+		secrets.Rotate(client.Secret{ID: "v2", Value: "new-secret"}, time.Hour)
+
+		for _, secret := range []string{"old-secret", "new-secret"} {
+			callback := &IDRCallback{
+				IDRPaymentID:  "PAY123",
+				Amount:        "50000.00",
+				TransactionID: "TXN123456789",
+				Status:        constants.StatusSuccess,
+				Signature:     signature.Generate("PAY12350000.00TXN1234567891" + secret),
+			}
+			assert.NoError(t, rotating.VerifyCallback(callback), secret)
+		}
+
+		secrets.Set(client.Secret{ID: "v2", Value: "new-secret"})
+		callback := &IDRCallback{
+			IDRPaymentID:  "PAY123",
+			Amount:        "50000.00",
+			TransactionID: "TXN123456789",
+			Status:        constants.StatusSuccess,
+			Signature:     signature.Generate("PAY12350000.00TXN1234567891old-secret"),
+		}
+		assert.ErrorIs(t, rotating.VerifyCallback(callback), errors.ErrInvalidSignature)
+	})
+
 	t.Run("rejects missing required fields", func(t *testing.T) {
 		testCases := []struct {
 			name     string
@@ -440,6 +660,92 @@ func TestIDRService_VerifyCallback_JSONDecode(t *testing.T) {
 	}
 }
 
+func TestIDRService_VerifyCallbackBytes(t *testing.T) {
+	body := func(amount string) []byte {
+		sig := signature.Generate("16681210000.50TXN1234567891secret-key")
+		return fmt.Appendf(nil, `{"idrpayment_id":166812,"transaction_id":"TXN123456789","amount":%s,"status":1,"remark":"","signature":%q}`, amount, sig)
+	}
+
+	t.Run("decodes with number preservation and verifies", func(t *testing.T) {
+		svc := NewIDRService(client.New("auth-key", "secret-key"))
+
+		callback, err := svc.VerifyCallbackBytes(body("10000.50"))
+		require.NoError(t, err)
+		assert.Equal(t, json.Number("166812"), callback.IDRPaymentID)
+		assert.Equal(t, json.Number("10000.50"), callback.Amount)
+		assert.Equal(t, constants.StatusSuccess, callback.Status)
+	})
+
+	t.Run("rejects tampered amount", func(t *testing.T) {
+		svc := NewIDRService(client.New("auth-key", "secret-key"))
+
+		_, err := svc.VerifyCallbackBytes(body("99999999.00"))
+		assert.ErrorIs(t, err, errors.ErrInvalidSignature)
+	})
+
+	t.Run("rejects invalid JSON", func(t *testing.T) {
+		svc := NewIDRService(client.New("auth-key", "secret-key"))
+
+		_, err := svc.VerifyCallbackBytes([]byte(`{"idrpayment_id":`))
+		assert.ErrorIs(t, err, errors.ErrInvalidJSON)
+	})
+
+	t.Run("checks source IP when given", func(t *testing.T) {
+		svc := NewIDRService(client.New("auth-key", "secret-key", client.WithCallbackIPWhitelist("192.168.1.1")))
+
+		_, err := svc.VerifyCallbackBytes(body("10000.50"), client.WithCallbackSourceIP("192.168.1.1"))
+		assert.NoError(t, err)
+
+		_, err = svc.VerifyCallbackBytes(body("10000.50"), client.WithCallbackSourceIP("10.0.0.1"))
+		assert.ErrorIs(t, err, errors.ErrIPNotWhitelisted)
+	})
+
+	t.Run("strict mode rejects duplicate and unknown keys", func(t *testing.T) {
+		svc := NewIDRService(client.New("auth-key", "secret-key", client.WithStrictCallbacks(true)))
+
+		_, err := svc.VerifyCallbackBytes(body(`10000.50,"amount":1`))
+		assert.ErrorIs(t, err, errors.ErrDuplicateKey)
+
+		_, err = svc.VerifyCallbackBytes(body(`10000.50,"bonus":1`))
+		assert.ErrorIs(t, err, errors.ErrInvalidJSON)
+	})
+}
+
+func TestIDRService_VerifyCallbackRequest(t *testing.T) {
+	sig := signature.Generate("16681210000.50TXN1234567891secret-key")
+	payload := fmt.Sprintf(`{"idrpayment_id":166812,"transaction_id":"TXN123456789","amount":10000.50,"status":1,"signature":%q}`, sig)
+	newRequest := func(remoteAddr string) *http.Request {
+		r := httptest.NewRequest(http.MethodPost, "/callback", strings.NewReader(payload))
+		r.RemoteAddr = remoteAddr
+		return r
+	}
+
+	t.Run("verifies IP and signature in one step", func(t *testing.T) {
+		svc := NewIDRService(client.New("auth-key", "secret-key", client.WithCallbackIPWhitelist("192.168.1.1")))
+
+		callback, err := svc.VerifyCallbackRequest(newRequest("192.168.1.1:40000"))
+		require.NoError(t, err)
+		assert.Equal(t, json.Number("10000.50"), callback.Amount)
+
+		_, err = svc.VerifyCallbackRequest(newRequest("10.0.0.1:40000"))
+		assert.ErrorIs(t, err, errors.ErrIPNotWhitelisted)
+	})
+
+	t.Run("source IP option overrides RemoteAddr", func(t *testing.T) {
+		svc := NewIDRService(client.New("auth-key", "secret-key", client.WithCallbackIPWhitelist("192.168.1.1")))
+
+		_, err := svc.VerifyCallbackRequest(newRequest("10.0.0.1:40000"), client.WithCallbackSourceIP("192.168.1.1"))
+		assert.NoError(t, err)
+	})
+
+	t.Run("rejects oversized body", func(t *testing.T) {
+		svc := NewIDRService(client.New("auth-key", "secret-key", client.WithMaxCallbackSize(32)))
+
+		_, err := svc.VerifyCallbackRequest(newRequest("192.168.1.1:40000"))
+		assert.ErrorIs(t, err, errors.ErrCallbackTooLarge)
+	})
+}
+
 func TestIDRService_VerifyCallbackWithIP(t *testing.T) {
 	t.Run("verifies callback with whitelisted IP", func(t *testing.T) {
 		c := client.New("auth-key", "secret-key", client.WithCallbackIPWhitelist("192.168.1.1"))
@@ -0,0 +1,34 @@
+// Copyright 2026 H0llyW00dzZ
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package payment
+
+// Option configures an [IDRService].
+type Option func(*IDRService)
+
+// WithStrictChannels makes [IDRService.Create] reject a channel that is not
+// registered (see constants.RegisterChannel), or an amount outside the
+// channel's limits, with a validation error.
+//
+// Without it, an unknown channel is dropped and the user chooses a channel
+// on the payment page.
+//
+// Example:
+//
+//	paymentSvc := payment.NewIDRService(c, payment.WithStrictChannels())
+func WithStrictChannels() Option {
+	return func(s *IDRService) {
+		s.strictChannels = true
+	}
+}
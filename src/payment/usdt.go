@@ -17,12 +17,16 @@ package payment
 import (
 	"context"
 	"fmt"
+	"net/http"
+	"time"
 
 	"github.com/H0llyW00dzZ/gspay-go-sdk/src/client"
 	"github.com/H0llyW00dzZ/gspay-go-sdk/src/constants"
 	"github.com/H0llyW00dzZ/gspay-go-sdk/src/errors"
 	amountfmt "github.com/H0llyW00dzZ/gspay-go-sdk/src/helper/amount"
+	"github.com/H0llyW00dzZ/gspay-go-sdk/src/helper/expiry"
 	"github.com/H0llyW00dzZ/gspay-go-sdk/src/i18n"
+	"github.com/H0llyW00dzZ/gspay-go-sdk/src/internal/validate"
 )
 
 // USDTRequest represents a request to create a USDT payment.
@@ -35,6 +39,35 @@ type USDTRequest struct {
 	Amount float64 `json:"amount"`
 }
 
+// Validate checks every field of the request and returns all failures as
+// an [errors.ValidationErrors] with English messages. It returns nil if the
+// request is valid. Use [USDTRequest.ValidateLang] for another language.
+func (r *USDTRequest) Validate() error {
+	return r.ValidateLang(i18n.English)
+}
+
+// ValidateLang is like [USDTRequest.Validate] but localizes the messages in lang.
+// Create runs the same checks in the client language.
+func (r *USDTRequest) ValidateLang(lang i18n.Language) error {
+	if errs := r.check(lang); len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+// check checks every field of the request, localized in lang.
+func (r *USDTRequest) check(lang i18n.Language) errors.ValidationErrors {
+	var errs errors.ValidationErrors
+	validate.TransactionID(&errs, lang, r.TransactionID)
+	validate.Username(&errs, lang, r.Username)
+
+	// Minimum 1.00 USDT
+	if r.Amount < constants.MinAmountUSDT {
+		errs.AddKey(lang, "amount", errors.ErrInvalidAmount, errors.KeyMinAmountUSDT)
+	}
+	return errs
+}
+
 // usdtAPIRequest is the internal API request structure.
 type usdtAPIRequest struct {
 	TransactionID string `json:"transaction_id"`
@@ -49,8 +82,43 @@ type USDTResponse struct {
 	PaymentURL string `json:"payment_url"`
 	// CryptoPaymentID is the unique payment ID assigned by GSPAY2.
 	CryptoPaymentID string `json:"cryptopayment_id"`
-	// ExpireDate is the payment expiration date/time.
+	// ExpireDate is the payment expiration date/time as sent by GSPAY2.
+	// Use [USDTResponse.ExpiresAt] for the parsed time.
 	ExpireDate string `json:"expire_date"`
+
+	// expiry interprets ExpireDate; set by Create, zero otherwise.
+	expiry expiryContext
+}
+
+// ExpiresAt parses ExpireDate in the client's server location
+// (see [client.WithServerLocation]). An unknown format returns an error
+// wrapping [errors.ErrInvalidExpireDate].
+//
+// The location is recorded by Create. On a response built or decoded by the
+// caller, ExpireDate is read in [constants.DefaultServerLocation] (WIB) and
+// errors are in English; use [expiry.Parse] to choose the location.
+func (r *USDTResponse) ExpiresAt() (time.Time, error) {
+	return r.expiry.parse(r.ExpireDate)
+}
+
+// TimeRemaining returns the time left until the payment expires, or zero if
+// it has expired or ExpireDate cannot be parsed.
+func (r *USDTResponse) TimeRemaining() time.Duration {
+	t, err := r.ExpiresAt()
+	if err != nil {
+		return 0
+	}
+	return expiry.Remaining(t, time.Now())
+}
+
+// IsExpired reports whether the payment has expired at now. It returns false
+// if ExpireDate cannot be parsed; call [USDTResponse.ExpiresAt] to get the error.
+func (r *USDTResponse) IsExpired(now time.Time) bool {
+	t, err := r.ExpiresAt()
+	if err != nil {
+		return false
+	}
+	return expiry.IsExpired(t, now)
 }
 
 // USDTCallback represents the callback data received from GSPAY2 for USDT payments.
@@ -80,29 +148,34 @@ func NewUSDTService(c *client.Client) *USDTService {
 // The generated order expires after approximately 2 minutes.
 //
 // Signature formula: MD5(transaction_id + player_username + amount + operator_secret_key)
-func (s *USDTService) Create(ctx context.Context, req *USDTRequest) (*USDTResponse, error) {
-	s.client.Logger().Info(s.client.I18n(i18n.LogCreatingUSDTPayment),
+func (s *USDTService) Create(ctx context.Context, req *USDTRequest, opts ...client.CallOption) (*USDTResponse, error) {
+	c := s.client.WithCallOptions(opts...)
+	c.Logger().Info(c.I18n(i18n.LogCreatingUSDTPayment),
 		"transactionID", req.TransactionID,
 		"username", req.Username,
 		"amount", req.Amount,
 	)
 
-	// Validate amount (minimum 1.00 USDT)
-	if req.Amount < constants.MinAmountUSDT {
-		return nil, errors.NewValidationError(s.client.Language, "amount", s.client.I18n(errors.KeyMinAmountUSDT))
+	if errs := req.check(c.Language); len(errs) > 0 {
+		return nil, errs
 	}
 
 	// Format amount with 2 decimal places
 	formattedAmount := amountfmt.FormatFloat(req.Amount)
 
+	c, err := c.Resolve(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	// Generate signature: transaction_id + player_username + amount + secret_key
 	signatureData := fmt.Sprintf("%s%s%s%s",
 		req.TransactionID,
 		req.Username,
 		formattedAmount,
-		s.client.SecretKey,
+		c.SigningSecret(),
 	)
-	sig := s.client.GenerateSignature(signatureData)
+	sig := c.GenerateSignature(signatureData)
 
 	// Build API request
 	apiReq := usdtAPIRequest{
@@ -112,18 +185,22 @@ func (s *USDTService) Create(ctx context.Context, req *USDTRequest) (*USDTRespon
 		Signature:     sig,
 	}
 
-	endpoint := fmt.Sprintf(constants.GetEndpoint(constants.EndpointUSDTCreate), s.client.AuthKey)
-	resp, err := s.client.Post(ctx, endpoint, apiReq)
+	endpoint := fmt.Sprintf(constants.GetEndpoint(constants.EndpointUSDTCreate), c.AuthKey)
+	resp, err := c.Post(ctx, endpoint, apiReq)
 	if err != nil {
 		return nil, err
 	}
 
-	result, err := client.ParseData[USDTResponse](resp.Data, s.client.Language)
+	result, err := client.ParseData[USDTResponse](resp.Data, c.Language)
 	if err != nil {
 		return nil, err
 	}
+	if result == nil {
+		return nil, c.Error(errors.ErrEmptyResponse)
+	}
+	result.expiry = expiryContext{loc: c.ServerLocation(), lang: c.Language}
 
-	s.client.Logger().Info(s.client.I18n(i18n.LogUSDTPaymentCreated),
+	c.Logger().Info(c.I18n(i18n.LogUSDTPaymentCreated),
 		"transactionID", req.TransactionID, // Response doesn't include transactionID, so use request's
 		"paymentID", result.CryptoPaymentID,
 	)
@@ -138,8 +215,9 @@ func (s *USDTService) Create(ctx context.Context, req *USDTRequest) (*USDTRespon
 //
 // Formula: MD5(cryptopayment_id + amount + transaction_id + status + operator_secret_key)
 // Note: Amount should be formatted with 2 decimal places (e.g., "10.50").
-func (s *USDTService) VerifySignature(cryptoPaymentID, amount, transactionID string, status constants.PaymentStatus, receivedSignature string) error {
-	s.client.Logger().Debug(s.client.I18n(i18n.LogVerifyingUSDTSignature),
+func (s *USDTService) VerifySignature(cryptoPaymentID, amount, transactionID string, status constants.PaymentStatus, receivedSignature string, opts ...client.CallOption) error {
+	c := s.client.WithCallOptions(opts...)
+	c.Logger().Debug(c.I18n(i18n.LogVerifyingUSDTSignature),
 		"paymentID", cryptoPaymentID,
 		"transactionID", transactionID,
 		"amount", amount,
@@ -148,52 +226,54 @@ func (s *USDTService) VerifySignature(cryptoPaymentID, amount, transactionID str
 
 	// Check required fields
 	if cryptoPaymentID == "" {
-		s.client.Logger().Warn(s.client.I18n(i18n.LogUSDTSigVerifyFailedMissing), "field", "cryptopayment_id")
-		return s.client.Error(errors.ErrMissingCallbackField, "cryptopayment_id")
+		c.Logger().Warn(c.I18n(i18n.LogUSDTSigVerifyFailedMissing), "field", "cryptopayment_id")
+		return c.Error(errors.ErrMissingCallbackField, "cryptopayment_id")
 	}
 	if amount == "" {
-		s.client.Logger().Warn(s.client.I18n(i18n.LogUSDTSigVerifyFailedMissing), "field", "amount")
-		return s.client.Error(errors.ErrMissingCallbackField, "amount")
+		c.Logger().Warn(c.I18n(i18n.LogUSDTSigVerifyFailedMissing), "field", "amount")
+		return c.Error(errors.ErrMissingCallbackField, "amount")
 	}
 	if transactionID == "" {
-		s.client.Logger().Warn(s.client.I18n(i18n.LogUSDTSigVerifyFailedMissing), "field", "transaction_id")
-		return s.client.Error(errors.ErrMissingCallbackField, "transaction_id")
+		c.Logger().Warn(c.I18n(i18n.LogUSDTSigVerifyFailedMissing), "field", "transaction_id")
+		return c.Error(errors.ErrMissingCallbackField, "transaction_id")
 	}
 	if receivedSignature == "" {
-		s.client.Logger().Warn(s.client.I18n(i18n.LogUSDTSigVerifyFailedMissing), "field", "signature")
-		return s.client.Error(errors.ErrMissingCallbackField, "signature")
+		c.Logger().Warn(c.I18n(i18n.LogUSDTSigVerifyFailedMissing), "field", "signature")
+		return c.Error(errors.ErrMissingCallbackField, "signature")
 	}
 
 	// Format amount with 2 decimal places
-	formattedAmount, err := amountfmt.Format(amount, s.client.Language)
+	formattedAmount, err := amountfmt.Format(amount, c.Language)
 	if err != nil {
-		s.client.Logger().Warn(s.client.I18n(i18n.LogUSDTSigVerifyFailedFormat),
+		c.Logger().Warn(c.I18n(i18n.LogUSDTSigVerifyFailedFormat),
 			"amount", amount,
 			"error", err.Error(),
 		)
 		return err
 	}
 
-	// Generate expected signature
-	signatureData := fmt.Sprintf("%s%s%s%d%s",
+	// Build signature data (each accepted secret key is appended when matching)
+	signatureData := fmt.Sprintf("%s%s%s%d",
 		cryptoPaymentID,
 		formattedAmount,
 		transactionID,
 		status,
-		s.client.SecretKey,
 	)
-	expectedSignature := s.client.GenerateSignature(signatureData)
 
-	// Constant-time comparison to prevent timing attacks
-	if !s.client.VerifySignature(expectedSignature, receivedSignature) {
-		s.client.Logger().Warn(s.client.I18n(i18n.LogUSDTSigVerifyFailedMismatch),
+	// Constant-time comparison against every accepted secret key
+	_, ok, err := c.MatchSignature(signatureData, receivedSignature)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		c.Logger().Warn(c.I18n(i18n.LogUSDTSigVerifyFailedMismatch),
 			"paymentID", cryptoPaymentID,
 			"transactionID", transactionID,
 		)
-		return s.client.Error(errors.ErrInvalidSignature)
+		return c.Error(errors.ErrInvalidSignature)
 	}
 
-	s.client.Logger().Debug(s.client.I18n(i18n.LogUSDTSignatureVerified),
+	c.Logger().Debug(c.I18n(i18n.LogUSDTSignatureVerified),
 		"paymentID", cryptoPaymentID,
 		"transactionID", transactionID,
 	)
@@ -206,7 +286,7 @@ func (s *USDTService) VerifySignature(cryptoPaymentID, amount, transactionID str
 //
 // This method only verifies the signature. To also verify the source IP,
 // use [USDTService.VerifyCallbackWithIP] instead.
-func (s *USDTService) VerifyCallback(callback *USDTCallback) error {
+func (s *USDTService) VerifyCallback(callback *USDTCallback, opts ...client.CallOption) error {
 	// Delegate to VerifySignature which handles all logging
 	return s.VerifySignature(
 		callback.CryptoPaymentID,
@@ -214,6 +294,7 @@ func (s *USDTService) VerifyCallback(callback *USDTCallback) error {
 		callback.TransactionID,
 		callback.Status,
 		callback.Signature,
+		opts...,
 	)
 }
 
@@ -225,16 +306,17 @@ func (s *USDTService) VerifyCallback(callback *USDTCallback) error {
 // If the client was configured with [WithCallbackIPWhitelist], this method will
 // verify that the source IP is in the whitelist before verifying the signature.
 // If no whitelist was configured, IP verification is skipped.
-func (s *USDTService) VerifyCallbackWithIP(callback *USDTCallback, sourceIP string) error {
-	s.client.Logger().Debug(s.client.I18n(i18n.LogVerifyingUSDTCallback),
+func (s *USDTService) VerifyCallbackWithIP(callback *USDTCallback, sourceIP string, opts ...client.CallOption) error {
+	c := s.client.WithCallOptions(opts...)
+	c.Logger().Debug(c.I18n(i18n.LogVerifyingUSDTCallback),
 		"transactionID", callback.TransactionID,
 		"paymentID", callback.CryptoPaymentID,
 		"sourceIP", sourceIP,
 	)
 
 	// Verify IP first (fast fail)
-	if err := s.client.VerifyCallbackIP(sourceIP); err != nil {
-		s.client.Logger().Warn(s.client.I18n(i18n.LogUSDTCallbackIPFailed),
+	if err := c.VerifyCallbackIP(sourceIP); err != nil {
+		c.Logger().Warn(c.I18n(i18n.LogUSDTCallbackIPFailed),
 			"sourceIP", sourceIP,
 			"error", err.Error(),
 		)
@@ -242,14 +324,78 @@ func (s *USDTService) VerifyCallbackWithIP(callback *USDTCallback, sourceIP stri
 	}
 
 	// Then verify signature (VerifySignature handles failure logging)
-	if err := s.VerifyCallback(callback); err != nil {
+	if err := s.VerifyCallback(callback, opts...); err != nil {
 		return err
 	}
 
-	s.client.Logger().Info(s.client.I18n(i18n.LogUSDTCallbackVerified),
+	c.Logger().Info(c.I18n(i18n.LogUSDTCallbackVerified),
 		"transactionID", callback.TransactionID,
 		"paymentID", callback.CryptoPaymentID,
 		"status", callback.Status,
 	)
 	return nil
 }
+
+// VerifyCallbackBytes decodes a raw USDT payment callback body and verifies it.
+//
+// The body is decoded with [client.Client.DecodeCallback], so numbers keep
+// the exact form GSPAY2 signed and the size and strictness settings of the
+// client apply. If [client.WithCallbackSourceIP] is given, the source IP is
+// verified as in [USDTService.VerifyCallbackWithIP]; otherwise only the signature
+// is verified.
+//
+// Example:
+//
+//	callback, err := svc.VerifyCallbackBytes(body, client.WithCallbackSourceIP(ip))
+//	if err != nil {
+//	    // Reject the callback
+//	}
+func (s *USDTService) VerifyCallbackBytes(body []byte, opts ...client.CallOption) (*USDTCallback, error) {
+	c := s.client.WithCallOptions(opts...)
+	var callback USDTCallback
+	if err := c.DecodeCallback(body, &callback); err != nil {
+		return nil, err
+	}
+
+	var err error
+	if sourceIP, ok := c.CallbackSourceIP(); ok {
+		err = s.VerifyCallbackWithIP(&callback, sourceIP, opts...)
+	} else {
+		err = s.VerifyCallback(&callback, opts...)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &callback, nil
+}
+
+// VerifyCallbackRequest reads, decodes and verifies a USDT payment callback
+// request in one step: body size, JSON, source IP and signature.
+//
+// The source IP is [http.Request.RemoteAddr]. Behind a reverse proxy, pass
+// the client IP it resolved with [client.WithCallbackSourceIP].
+//
+// Example:
+//
+//	http.HandleFunc("POST /callback", func(w http.ResponseWriter, r *http.Request) {
+//	    callback, err := svc.VerifyCallbackRequest(r)
+//	    if err != nil {
+//	        http.Error(w, "invalid callback", http.StatusBadRequest)
+//	        return
+//	    }
+//	    // Process callback
+//	    w.Write([]byte("OK"))
+//	})
+func (s *USDTService) VerifyCallbackRequest(r *http.Request, opts ...client.CallOption) (*USDTCallback, error) {
+	body, err := s.client.WithCallOptions(opts...).ReadCallback(r)
+	if err != nil {
+		return nil, err
+	}
+	return s.VerifyCallbackBytes(body, append([]client.CallOption{client.WithCallbackSourceIP(r.RemoteAddr)}, opts...)...)
+}
+
+// MaxCallbackSize returns the largest USDT payment callback body the service
+// accepts, set by [client.WithMaxCallbackSize].
+func (s *USDTService) MaxCallbackSize() int64 {
+	return s.client.MaxCallbackSize()
+}
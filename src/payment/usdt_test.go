@@ -21,10 +21,12 @@ import (
 	"net/http/httptest"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/H0llyW00dzZ/gspay-go-sdk/src/client"
 	"github.com/H0llyW00dzZ/gspay-go-sdk/src/constants"
 	"github.com/H0llyW00dzZ/gspay-go-sdk/src/errors"
+	"github.com/H0llyW00dzZ/gspay-go-sdk/src/i18n"
 	"github.com/H0llyW00dzZ/gspay-go-sdk/src/internal/signature"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -65,6 +67,10 @@ func TestUSDTService_Create(t *testing.T) {
 		require.NotNil(t, resp)
 		assert.Equal(t, "https://pay.example.com/usdt", resp.PaymentURL)
 		assert.Equal(t, "CRYPTO123", resp.CryptoPaymentID)
+
+		expiresAt, err := resp.ExpiresAt()
+		require.NoError(t, err)
+		assert.True(t, time.Date(2026, 1, 26, 15, 2, 0, 0, constants.DefaultServerLocation).Equal(expiresAt))
 	})
 
 	t.Run("validates minimum amount", func(t *testing.T) {
@@ -82,6 +88,77 @@ func TestUSDTService_Create(t *testing.T) {
 		require.NotNil(t, valErr)
 		assert.Equal(t, "amount", valErr.Field)
 	})
+
+	t.Run("returns error on missing data", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			json.NewEncoder(w).Encode(map[string]any{
+				"code":    200,
+				"message": "success",
+			})
+		}))
+		defer server.Close()
+
+		c := client.New("auth-key", "secret-key", client.WithBaseURL(server.URL))
+		svc := NewUSDTService(c)
+
+		resp, err := svc.Create(t.Context(), &USDTRequest{
+			TransactionID: "TXN123456789",
+			Username:      "user123",
+			Amount:        10.50,
+		})
+		assert.Nil(t, resp)
+		assert.ErrorIs(t, err, errors.ErrEmptyResponse)
+	})
+}
+
+func TestUSDTRequest_Validate(t *testing.T) {
+	t.Run("valid request", func(t *testing.T) {
+		req := &USDTRequest{TransactionID: "TXN123456789", Username: "user123", Amount: 10.5}
+		assert.NoError(t, req.Validate())
+	})
+
+	t.Run("reports every failing field", func(t *testing.T) {
+		errs := errors.GetValidationErrors((&USDTRequest{TransactionID: "TX", Amount: 0.5}).Validate())
+		require.Len(t, errs, 3)
+		assert.NotNil(t, errs.Field("transaction_id"))
+		assert.NotNil(t, errs.Field("username"))
+		assert.NotNil(t, errs.Field("amount"))
+		assert.Nil(t, errs.Field("bank_code"))
+	})
+
+	t.Run("validate lang localizes every failing field", func(t *testing.T) {
+		errs := errors.GetValidationErrors((&USDTRequest{TransactionID: "TX", Amount: 0.5}).ValidateLang(i18n.Indonesian))
+		require.Len(t, errs, 3)
+		assert.Equal(t, i18n.Get(i18n.Indonesian, i18n.MsgMinAmountUSDT), errs.Field("amount").Message)
+	})
+
+	t.Run("create checks transaction ID", func(t *testing.T) {
+		svc := NewUSDTService(client.New("auth-key", "secret-key"))
+		_, err := svc.Create(t.Context(), &USDTRequest{TransactionID: "TX", Username: "user123", Amount: 10})
+		assert.ErrorIs(t, err, errors.ErrInvalidTransactionID)
+	})
+}
+
+func TestUSDTResponse_Expiry(t *testing.T) {
+	now := time.Date(2026, 1, 26, 15, 0, 0, 0, constants.DefaultServerLocation)
+
+	t.Run("zero value uses server time", func(t *testing.T) {
+		resp := &USDTResponse{ExpireDate: "2026-01-26 15:02:00"}
+		expiresAt, err := resp.ExpiresAt()
+		require.NoError(t, err)
+		assert.Equal(t, constants.DefaultServerLocation, expiresAt.Location())
+		assert.False(t, resp.IsExpired(now))
+		assert.True(t, resp.IsExpired(now.Add(2*time.Minute)))
+	})
+
+	t.Run("unknown format", func(t *testing.T) {
+		resp := &USDTResponse{ExpireDate: "soon"}
+		_, err := resp.ExpiresAt()
+		assert.ErrorIs(t, err, errors.ErrInvalidExpireDate)
+		assert.False(t, resp.IsExpired(now))
+		assert.Zero(t, resp.TimeRemaining())
+	})
 }
 
 func TestUSDTService_VerifyCallback(t *testing.T) {
@@ -202,6 +279,42 @@ func TestUSDTService_VerifyCallback_JSONDecode(t *testing.T) {
 	})
 }
 
+func TestUSDTService_VerifyCallbackBytes(t *testing.T) {
+	sig := signature.Generate("CRYPTO12310.50TXN1234567891secret-key")
+	payload := fmt.Sprintf(`{"cryptopayment_id":"CRYPTO123","amount":"10.50","transaction_id":"TXN123456789","status":1,"signature":%q}`, sig)
+
+	t.Run("decodes and verifies", func(t *testing.T) {
+		svc := NewUSDTService(client.New("auth-key", "secret-key"))
+
+		callback, err := svc.VerifyCallbackBytes([]byte(payload))
+		require.NoError(t, err)
+		assert.Equal(t, "CRYPTO123", callback.CryptoPaymentID)
+		assert.Equal(t, "10.50", callback.Amount)
+	})
+
+	t.Run("rejects invalid signature", func(t *testing.T) {
+		svc := NewUSDTService(client.New("auth-key", "other-secret"))
+
+		_, err := svc.VerifyCallbackBytes([]byte(payload))
+		assert.ErrorIs(t, err, errors.ErrInvalidSignature)
+	})
+
+	t.Run("request verifies IP and signature", func(t *testing.T) {
+		svc := NewUSDTService(client.New("auth-key", "secret-key", client.WithCallbackIPWhitelist("192.168.1.0/24")))
+
+		r := httptest.NewRequest(http.MethodPost, "/callback", strings.NewReader(payload))
+		r.RemoteAddr = "192.168.1.7:40000"
+		callback, err := svc.VerifyCallbackRequest(r)
+		require.NoError(t, err)
+		assert.Equal(t, "TXN123456789", callback.TransactionID)
+
+		r = httptest.NewRequest(http.MethodPost, "/callback", strings.NewReader(payload))
+		r.RemoteAddr = "10.0.0.1:40000"
+		_, err = svc.VerifyCallbackRequest(r)
+		assert.ErrorIs(t, err, errors.ErrIPNotWhitelisted)
+	})
+}
+
 func TestUSDTService_VerifyCallbackWithIP(t *testing.T) {
 	t.Run("verifies callback with whitelisted IP", func(t *testing.T) {
 		c := client.New("auth-key", "secret-key", client.WithCallbackIPWhitelist("192.168.1.1"))
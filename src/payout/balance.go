@@ -0,0 +1,142 @@
+// Copyright 2026 H0llyW00dzZ
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package payout
+
+import (
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/H0llyW00dzZ/gspay-go-sdk/src/balance"
+	"github.com/H0llyW00dzZ/gspay-go-sdk/src/client"
+	"github.com/H0llyW00dzZ/gspay-go-sdk/src/errors"
+	"github.com/H0llyW00dzZ/gspay-go-sdk/src/i18n"
+)
+
+// BalanceSource provides the cached operator balance.
+//
+// [*balance.Watcher] implements this interface.
+type BalanceSource interface {
+	// Latest returns the last known balance and whether one is available.
+	Latest() (balance.Snapshot, bool)
+}
+
+// Option is a functional option for configuring the [IDRService].
+type Option func(*IDRService)
+
+// WithBalanceCheck enables a pre-flight balance check before [IDRService.Create].
+//
+// The IDR balance from src is reduced by the amounts of payouts that are in
+// flight, or that succeeded or may have reached GSPAY2 after the snapshot was
+// taken. Only a payout that failed before reaching GSPAY2 gives its amount
+// back immediately. If the remaining balance cannot cover the payout, Create
+// returns a [*errors.ValidationError] wrapping [errors.ErrInsufficientBalance]
+// without calling the API; [errors.Classify] reports it as
+// [errors.CategoryValidation].
+//
+// If src has no balance yet, the check is skipped.
+//
+// Example:
+//
+//	w := balance.NewWatcher(balance.NewService(c))
+//	go w.Run(ctx)
+//
+//	payoutSvc := payout.NewIDRService(c, payout.WithBalanceCheck(w))
+func WithBalanceCheck(src BalanceSource) Option {
+	return func(s *IDRService) {
+		s.balance = src
+		s.reserved = &reservations{}
+	}
+}
+
+// reservation is an amount held against the cached balance.
+type reservation struct {
+	amount int64
+	// settledAt is when the payout succeeded; zero while in flight.
+	settledAt time.Time
+}
+
+// reservations tracks payout amounts not yet reflected in the cached balance.
+type reservations struct {
+	mu    sync.Mutex
+	items []*reservation
+}
+
+// reserve holds amount if snap can cover it along with existing reservations.
+// It returns the reservation and the balance available before reserving.
+func (r *reservations) reserve(snap balance.Snapshot, amount int64) (*reservation, float64, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	// Drop settled payouts that the snapshot already accounts for: its
+	// request was sent after the payout settled.
+	r.items = slices.DeleteFunc(r.items, func(res *reservation) bool {
+		return !res.settledAt.IsZero() && snap.UpdatedAt.After(res.settledAt)
+	})
+
+	available := snap.IDR
+	for _, res := range r.items {
+		available -= float64(res.amount)
+	}
+	if float64(amount) > available {
+		return nil, available, false
+	}
+
+	res := &reservation{amount: amount}
+	r.items = append(r.items, res)
+	return res, available, true
+}
+
+// release removes a reservation after a payout that never reached GSPAY2.
+func (r *reservations) release(res *reservation) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.items = slices.DeleteFunc(r.items, func(item *reservation) bool { return item == res })
+}
+
+// settle keeps a reservation until a newer balance snapshot reflects the
+// payout. It is also used when the outcome of a payout is unknown.
+func (r *reservations) settle(res *reservation) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	res.settledAt = time.Now()
+}
+
+// reserveBalance performs the pre-flight balance check for amount.
+// It returns a nil reservation if the check is disabled or skipped.
+func (s *IDRService) reserveBalance(c *client.Client, transactionID string, amount int64) (*reservation, error) {
+	if s.balance == nil {
+		return nil, nil
+	}
+
+	snap, ok := s.balance.Latest()
+	if !ok {
+		c.Logger().Debug(c.I18n(i18n.LogPayoutBalanceUnavailable), "transactionID", transactionID)
+		return nil, nil
+	}
+
+	res, available, ok := s.reserved.reserve(snap, amount)
+	if !ok {
+		c.Logger().Warn(c.I18n(i18n.LogPayoutInsufficientBalance),
+			"transactionID", transactionID,
+			"amount", amount,
+			"available", available,
+		)
+		valErr := errors.NewValidationErrorKey(c.Language, "amount", i18n.MsgAmountExceedsBalance, amount, available)
+		valErr.Err = errors.ErrInsufficientBalance
+		return nil, valErr
+	}
+	return res, nil
+}
@@ -0,0 +1,194 @@
+// Copyright 2026 H0llyW00dzZ
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package payout
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/H0llyW00dzZ/gspay-go-sdk/src/balance"
+	"github.com/H0llyW00dzZ/gspay-go-sdk/src/client"
+	"github.com/H0llyW00dzZ/gspay-go-sdk/src/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeBalance is a [BalanceSource] with a settable snapshot.
+type fakeBalance struct {
+	mu   sync.Mutex
+	snap balance.Snapshot
+	ok   bool
+}
+
+func (f *fakeBalance) Latest() (balance.Snapshot, bool) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.snap, f.ok
+}
+
+func (f *fakeBalance) Set(idr float64) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.snap = balance.Snapshot{IDR: idr, UpdatedAt: time.Now()}
+	f.ok = true
+}
+
+// payoutServer answers payout creation, failing when fail is set.
+func payoutServer(t *testing.T, fail *atomic.Bool) (*httptest.Server, *atomic.Int32) {
+	t.Helper()
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.Header().Set("Content-Type", "application/json")
+		if fail != nil && fail.Load() {
+			json.NewEncoder(w).Encode(map[string]any{"code": 400, "message": "bank maintenance"})
+			return
+		}
+		json.NewEncoder(w).Encode(map[string]any{
+			"code":    200,
+			"message": "success",
+			"data":    `{"idrpayout_id":123,"status":0}`,
+		})
+	}))
+	t.Cleanup(server.Close)
+	return server, &calls
+}
+
+func payoutRequest(txID string, amount int64) *IDRRequest {
+	return &IDRRequest{
+		TransactionID: txID,
+		Username:      "user123",
+		AccountName:   "John Doe",
+		AccountNumber: "1234567890",
+		Amount:        amount,
+		BankCode:      "BCA",
+	}
+}
+
+func TestIDRService_BalanceCheck(t *testing.T) {
+	t.Run("rejects payout exceeding balance locally", func(t *testing.T) {
+		server, calls := payoutServer(t, nil)
+		src := &fakeBalance{}
+		src.Set(40000)
+		svc := NewIDRService(client.New("auth-key", "secret-key", client.WithBaseURL(server.URL)), WithBalanceCheck(src))
+
+		_, err := svc.Create(t.Context(), payoutRequest("TXN123456789", 50000))
+		require.Error(t, err)
+		assert.ErrorIs(t, err, errors.ErrInsufficientBalance)
+		valErr := errors.GetValidationError(err)
+		require.NotNil(t, valErr)
+		assert.Equal(t, "amount", valErr.Field)
+		assert.Contains(t, err.Error(), "insufficient balance")
+		assert.Equal(t, errors.Classification{Category: errors.CategoryValidation}, errors.Classify(err))
+		assert.Zero(t, calls.Load())
+	})
+
+	t.Run("skips check without balance", func(t *testing.T) {
+		server, calls := payoutServer(t, nil)
+		svc := NewIDRService(client.New("auth-key", "secret-key", client.WithBaseURL(server.URL)), WithBalanceCheck(&fakeBalance{}))
+
+		_, err := svc.Create(t.Context(), payoutRequest("TXN123456789", 50000))
+		require.NoError(t, err)
+		assert.Equal(t, int32(1), calls.Load())
+	})
+
+	t.Run("successful payouts are reserved until a newer snapshot", func(t *testing.T) {
+		server, _ := payoutServer(t, nil)
+		src := &fakeBalance{}
+		src.Set(100000)
+		svc := NewIDRService(client.New("auth-key", "secret-key", client.WithBaseURL(server.URL)), WithBalanceCheck(src))
+
+		_, err := svc.Create(t.Context(), payoutRequest("TXN000000001", 60000))
+		require.NoError(t, err)
+
+		_, err = svc.Create(t.Context(), payoutRequest("TXN000000002", 60000))
+		assert.ErrorIs(t, err, errors.ErrInsufficientBalance)
+
+		// The watcher picks up the deducted balance.
+		time.Sleep(time.Millisecond)
+		src.Set(40000)
+		_, err = svc.Create(t.Context(), payoutRequest("TXN000000003", 40000))
+		assert.NoError(t, err)
+	})
+
+	t.Run("payouts that never reached GSPAY2 release their reservation", func(t *testing.T) {
+		server, _ := payoutServer(t, nil)
+		server.Close()
+		src := &fakeBalance{}
+		src.Set(100000)
+		svc := NewIDRService(client.New("auth-key", "secret-key", client.WithBaseURL(server.URL), client.WithRetries(0)), WithBalanceCheck(src))
+
+		_, err := svc.Create(t.Context(), payoutRequest("TXN000000001", 60000))
+		require.Error(t, err)
+		require.False(t, errors.Classify(err).MayHaveReachedServer)
+
+		// Held funds would make this an insufficient balance error.
+		_, err = svc.Create(t.Context(), payoutRequest("TXN000000002", 60000))
+		require.Error(t, err)
+		assert.NotErrorIs(t, err, errors.ErrInsufficientBalance)
+	})
+
+	t.Run("payouts that may have reached GSPAY2 stay reserved until a newer snapshot", func(t *testing.T) {
+		var fail atomic.Bool
+		fail.Store(true)
+		server, _ := payoutServer(t, &fail)
+		src := &fakeBalance{}
+		src.Set(100000)
+		svc := NewIDRService(client.New("auth-key", "secret-key", client.WithBaseURL(server.URL)), WithBalanceCheck(src))
+
+		_, err := svc.Create(t.Context(), payoutRequest("TXN000000001", 60000))
+		require.Error(t, err)
+		assert.NotErrorIs(t, err, errors.ErrInsufficientBalance)
+
+		fail.Store(false)
+		_, err = svc.Create(t.Context(), payoutRequest("TXN000000002", 60000))
+		assert.ErrorIs(t, err, errors.ErrInsufficientBalance)
+
+		time.Sleep(time.Millisecond)
+		src.Set(100000)
+		_, err = svc.Create(t.Context(), payoutRequest("TXN000000003", 60000))
+		assert.NoError(t, err)
+	})
+
+	t.Run("concurrent payouts cannot overcommit", func(t *testing.T) {
+		server, calls := payoutServer(t, nil)
+		src := &fakeBalance{}
+		src.Set(100000)
+		svc := NewIDRService(client.New("auth-key", "secret-key", client.WithBaseURL(server.URL)), WithBalanceCheck(src))
+
+		var wg sync.WaitGroup
+		var rejected atomic.Int32
+		for i := range 10 {
+			wg.Go(func() {
+				_, err := svc.Create(t.Context(), payoutRequest(fmt.Sprintf("TXN%09d", i), 30000))
+				if errors.Is(err, errors.ErrInsufficientBalance) {
+					rejected.Add(1)
+				} else {
+					assert.NoError(t, err)
+				}
+			})
+		}
+		wg.Wait()
+
+		assert.Equal(t, int32(3), calls.Load())
+		assert.Equal(t, int32(7), rejected.Load())
+	})
+}
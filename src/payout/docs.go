@@ -36,7 +36,8 @@
 //
 // # Supported Banks
 //
-// Use constants.IsValidBankIDR to validate bank codes.
+// Use constants.IsValidBankIDR to validate bank codes, or constants.LookupBank
+// to resolve a bank name such as "Mandiri" to its code.
 // Common Indonesian banks include: BCA, BRI, MANDIRI, BNI, CIMB, etc.
 //
 // E-wallets are also supported: DANA, OVO.
@@ -55,10 +56,39 @@
 //	    // Unauthorized IP or invalid signature
 //	}
 //
+// VerifyCallbackRequest reads, decodes and verifies a callback request in one
+// step, so handlers do not need to decode with json.Decoder.UseNumber
+// themselves. VerifyCallbackBytes does the same for a raw body:
+//
+//	callback, err := payoutSvc.VerifyCallbackRequest(r)
+//	if err != nil {
+//	    // Oversized body, invalid JSON, unauthorized IP or invalid signature
+//	}
+//
+// # Pre-flight Balance Check
+//
+// With [WithBalanceCheck], Create compares the payout amount against a cached
+// balance (such as a [balance.Watcher]) before calling the API. Amounts of
+// in-flight payouts are reserved, so concurrent payouts in one process cannot
+// overcommit the balance:
+//
+//	w := balance.NewWatcher(balance.NewService(c))
+//	go w.Run(ctx)
+//
+//	payoutSvc := payout.NewIDRService(c, payout.WithBalanceCheck(w))
+//
 // # Error Handling
 //
+// Create checks the whole request with [IDRRequest.Validate] first and
+// returns every failing field at once as an errors.ValidationErrors.
+//
 // Common validation errors (from the SDK errors package):
-//   - ErrInvalidAccountNumber: Invalid bank account number
+//   - ErrInvalidTransactionID: Transaction ID length or characters invalid
+//   - ErrInvalidUsername: Missing username
+//   - ErrInvalidAccountName: Missing account name
+//   - ErrInvalidAccountNumber: Missing or non-numeric bank account number
 //   - ErrInvalidBankCode: Unsupported bank code
 //   - ErrInvalidAmount: Amount below minimum or invalid
+//   - ErrInvalidDescription: Description longer than constants.MaxDescriptionLength
+//   - ErrInsufficientBalance: Cached balance cannot cover the payout (with [WithBalanceCheck])
 package payout
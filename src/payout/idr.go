@@ -18,6 +18,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"net/http"
 	"strings"
 
 	"github.com/H0llyW00dzZ/gspay-go-sdk/src/client"
@@ -25,6 +26,7 @@ import (
 	"github.com/H0llyW00dzZ/gspay-go-sdk/src/errors"
 	amountfmt "github.com/H0llyW00dzZ/gspay-go-sdk/src/helper/amount"
 	"github.com/H0llyW00dzZ/gspay-go-sdk/src/i18n"
+	"github.com/H0llyW00dzZ/gspay-go-sdk/src/internal/validate"
 )
 
 // IDRRequest represents a request to create an IDR payout (withdrawal).
@@ -39,12 +41,53 @@ type IDRRequest struct {
 	AccountNumber string `json:"account_number"`
 	// Amount is the payout amount in IDR (no decimals).
 	Amount int64 `json:"amount"`
-	// BankCode is the target bank code (see constants.BanksIDR).
+	// BankCode is the target bank code (see constants.Banks). Bank names and
+	// aliases such as "Mandiri" are accepted and resolved to the code.
 	BankCode string `json:"bank_target"`
 	// Description is an optional transaction description.
 	Description string `json:"trx_description,omitempty"`
 }
 
+// Validate checks every field of the request and returns all failures as
+// an [errors.ValidationErrors] with English messages. It returns nil if the
+// request is valid. Use [IDRRequest.ValidateLang] for another language.
+func (r *IDRRequest) Validate() error {
+	return r.ValidateLang(i18n.English)
+}
+
+// ValidateLang is like [IDRRequest.Validate] but localizes the messages in lang.
+// Create runs the same checks in the client language.
+func (r *IDRRequest) ValidateLang(lang i18n.Language) error {
+	if errs := r.check(lang); len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+// check checks every field of the request, localized in lang.
+func (r *IDRRequest) check(lang i18n.Language) errors.ValidationErrors {
+	var errs errors.ValidationErrors
+	validate.TransactionID(&errs, lang, r.TransactionID)
+	validate.Username(&errs, lang, r.Username)
+	validate.AccountName(&errs, lang, r.AccountName)
+
+	bank, ok := constants.LookupBank(constants.CurrencyIDR, r.BankCode)
+	if !ok || !bank.Payout {
+		errs.AddKey(lang, "bank_code", errors.ErrInvalidBankCode,
+			i18n.MsgValidationSubject, strings.ToUpper(r.BankCode), errors.MsgInvalidBankCode)
+		bank.Code = r.BankCode
+	}
+	validate.AccountNumber(&errs, lang, constants.CurrencyIDR, bank.Code, r.AccountNumber)
+
+	// Minimum 10000 IDR
+	if r.Amount < constants.MinAmountIDR {
+		errs.AddKey(lang, "amount", errors.ErrInvalidAmount, errors.KeyMinPayoutAmountIDR)
+	}
+
+	validate.Description(&errs, lang, r.Description)
+	return errs
+}
+
 // idrAPIRequest is the internal API request structure.
 type idrAPIRequest struct {
 	TransactionID string `json:"transaction_id"`
@@ -89,6 +132,15 @@ type IDRStatusResponse struct {
 	Signature string `json:"signature"`
 }
 
+// PaymentStatus returns the payout status normalized from the completion flags.
+//
+// A completed payout maps to [constants.StatusSuccess] or, if unsuccessful, to
+// [constants.StatusFailed] (or the reported failure status). An incomplete payout
+// returns the reported Status unchanged.
+func (r *IDRStatusResponse) PaymentStatus() constants.PaymentStatus {
+	return payoutStatus(r.Status, r.Completed, r.PayoutSuccess)
+}
+
 // IDRCallback represents the callback data received from GSPAY2 for IDR payouts.
 //
 // According to GSPAY2 documentation, the callback contains:
@@ -124,42 +176,89 @@ type IDRCallback struct {
 	Signature string `json:"signature"`
 }
 
+// PaymentStatus returns the payout status normalized from the completion flags.
+//
+// Payout callbacks carry no status code, so an incomplete payout is reported
+// as [constants.StatusPending].
+func (c *IDRCallback) PaymentStatus() constants.PaymentStatus {
+	return payoutStatus(constants.StatusPending, c.Completed, c.PayoutSuccess)
+}
+
+// payoutStatus derives a [constants.PaymentStatus] from the payout completion flags.
+func payoutStatus(status constants.PaymentStatus, completed, success bool) constants.PaymentStatus {
+	switch {
+	case !completed:
+		return status
+	case success:
+		return constants.StatusSuccess
+	case status.IsFailed():
+		return status
+	default:
+		return constants.StatusFailed
+	}
+}
+
 // IDRService handles IDR payout operations.
-type IDRService struct{ client *client.Client }
+type IDRService struct {
+	client   *client.Client
+	balance  BalanceSource
+	reserved *reservations
+}
 
 // NewIDRService creates a new IDR payout service.
-func NewIDRService(c *client.Client) *IDRService { return &IDRService{client: c} }
+func NewIDRService(c *client.Client, opts ...Option) *IDRService {
+	s := &IDRService{client: c}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
 
 // Create creates a new IDR payout (withdrawal) to an Indonesian bank account or e-wallet.
 //
 // Amount is deducted immediately from settlement balance.
+// If [WithBalanceCheck] is enabled, the cached balance is checked first.
 //
 // Signature formula: MD5(transaction_id + player_username + amount + account_number + operator_secret_key)
-func (s *IDRService) Create(ctx context.Context, req *IDRRequest) (*IDRResponse, error) {
-	s.client.Logger().Info(s.client.I18n(i18n.LogCreatingIDRPayout),
+func (s *IDRService) Create(ctx context.Context, req *IDRRequest, opts ...client.CallOption) (*IDRResponse, error) {
+	c := s.client.WithCallOptions(opts...)
+	c.Logger().Info(c.I18n(i18n.LogCreatingIDRPayout),
 		"transactionID", req.TransactionID,
 		"username", req.Username,
 		"amount", req.Amount,
 		"bankCode", req.BankCode,
-		"accountName", s.client.LogAccountName(req.AccountName),
-		"accountNumber", s.client.LogAccountNumber(req.AccountNumber),
+		"accountName", c.LogAccountName(req.AccountName),
+		"accountNumber", c.LogAccountNumber(req.AccountNumber),
 	)
 
-	// Validate transaction ID length
-	if len(req.TransactionID) < constants.MinTransactionIDLength ||
-		len(req.TransactionID) > constants.MaxTransactionIDLength {
-		return nil, errors.NewValidationError(s.client.Language, "transaction_id", s.client.I18n(errors.MsgInvalidTransactionID))
+	if errs := req.check(c.Language); len(errs) > 0 {
+		return nil, errs
 	}
+	bank, _ := constants.LookupBank(constants.CurrencyIDR, req.BankCode)
+	bankCode := bank.Code
 
-	// Validate bank code
-	bankCode := strings.ToUpper(req.BankCode)
-	if !constants.IsValidBankIDR(bankCode) {
-		return nil, errors.NewValidationError(s.client.Language, "bank_code", bankCode+": "+s.client.I18n(errors.MsgInvalidBankCode))
+	res, err := s.reserveBalance(c, req.TransactionID, req.Amount)
+	if err != nil {
+		return nil, err
+	}
+	result, err := s.create(ctx, c, req, bankCode)
+	if res != nil {
+		// A payout that may have reached GSPAY2 may also have been paid out,
+		// so its amount stays held until a newer balance reflects it.
+		if err != nil && !errors.Classify(err).MayHaveReachedServer {
+			s.reserved.release(res)
+		} else {
+			s.reserved.settle(res)
+		}
 	}
+	return result, err
+}
 
-	// Validate amount (minimum 10000 IDR)
-	if req.Amount < constants.MinAmountIDR {
-		return nil, errors.NewValidationError(s.client.Language, "amount", s.client.I18n(errors.KeyMinPayoutAmountIDR))
+// create signs and sends a validated IDR payout request.
+func (s *IDRService) create(ctx context.Context, c *client.Client, req *IDRRequest, bankCode string) (*IDRResponse, error) {
+	c, err := c.Resolve(ctx)
+	if err != nil {
+		return nil, err
 	}
 
 	// Generate signature: transaction_id + player_username + amount + account_number + secret_key
@@ -168,9 +267,9 @@ func (s *IDRService) Create(ctx context.Context, req *IDRRequest) (*IDRResponse,
 		req.Username,
 		req.Amount,
 		req.AccountNumber,
-		s.client.SecretKey,
+		c.SigningSecret(),
 	)
-	sig := s.client.GenerateSignature(signatureData)
+	sig := c.GenerateSignature(signatureData)
 
 	// Build API request
 	apiReq := idrAPIRequest{
@@ -187,18 +286,21 @@ func (s *IDRService) Create(ctx context.Context, req *IDRRequest) (*IDRResponse,
 		apiReq.Description = req.Description
 	}
 
-	endpoint := fmt.Sprintf(constants.GetEndpoint(constants.EndpointPayoutIDRCreate), s.client.AuthKey)
-	resp, err := s.client.Post(ctx, endpoint, apiReq)
+	endpoint := fmt.Sprintf(constants.GetEndpoint(constants.EndpointPayoutIDRCreate), c.AuthKey)
+	resp, err := c.Post(ctx, endpoint, apiReq)
 	if err != nil {
 		return nil, err
 	}
 
-	result, err := client.ParseData[IDRResponse](resp.Data, s.client.Language)
+	result, err := client.ParseData[IDRResponse](resp.Data, c.Language)
 	if err != nil {
 		return nil, err
 	}
+	if result == nil {
+		return nil, c.Error(errors.ErrEmptyResponse)
+	}
 
-	s.client.Logger().Info(s.client.I18n(i18n.LogIDRPayoutCreated),
+	c.Logger().Info(c.I18n(i18n.LogIDRPayoutCreated),
 		"transactionID", req.TransactionID,
 		"payoutID", result.IDRPayoutID,
 		"status", result.Status,
@@ -208,23 +310,32 @@ func (s *IDRService) Create(ctx context.Context, req *IDRRequest) (*IDRResponse,
 }
 
 // GetStatus retrieves the current status of an IDR payout.
-func (s *IDRService) GetStatus(ctx context.Context, transactionID string) (*IDRStatusResponse, error) {
-	s.client.Logger().Debug(s.client.I18n(i18n.LogQueryingIDRPayoutStatus), "transactionID", transactionID)
+func (s *IDRService) GetStatus(ctx context.Context, transactionID string, opts ...client.CallOption) (*IDRStatusResponse, error) {
+	c := s.client.WithCallOptions(opts...)
+	c.Logger().Debug(c.I18n(i18n.LogQueryingIDRPayoutStatus), "transactionID", transactionID)
 
-	endpoint := fmt.Sprintf(constants.GetEndpoint(constants.EndpointPayoutIDRStatus), s.client.AuthKey)
-	resp, err := s.client.Get(ctx, endpoint, map[string]string{
+	c, err := c.Resolve(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	endpoint := fmt.Sprintf(constants.GetEndpoint(constants.EndpointPayoutIDRStatus), c.AuthKey)
+	resp, err := c.Get(ctx, endpoint, map[string]string{
 		"transaction_id": transactionID,
 	})
 	if err != nil {
 		return nil, err
 	}
 
-	result, err := client.ParseData[IDRStatusResponse](resp.Data, s.client.Language)
+	result, err := client.ParseData[IDRStatusResponse](resp.Data, c.Language)
 	if err != nil {
 		return nil, err
 	}
+	if result == nil {
+		return nil, c.Error(errors.ErrEmptyResponse)
+	}
 
-	s.client.Logger().Info(s.client.I18n(i18n.LogIDRPayoutStatusRetrieved),
+	c.Logger().Info(c.I18n(i18n.LogIDRPayoutStatusRetrieved),
 		"transactionID", result.TransactionID,
 		"status", result.Status,
 		"payoutID", result.IDRPayoutID,
@@ -240,73 +351,105 @@ func (s *IDRService) GetStatus(ctx context.Context, transactionID string) (*IDRS
 //
 // Formula: MD5(id + account_number + amount + transaction_id + operator_secret_key)
 // Note: Amount should be formatted with 2 decimal places (e.g., "10000.00").
-func (s *IDRService) VerifySignature(id, accountNumber, amount, transactionID, receivedSignature string) error {
-	s.client.Logger().Debug(s.client.I18n(i18n.LogVerifyingIDRPayoutSig),
+func (s *IDRService) VerifySignature(id, accountNumber, amount, transactionID, receivedSignature string, opts ...client.CallOption) error {
+	c := s.client.WithCallOptions(opts...)
+	c.Logger().Debug(c.I18n(i18n.LogVerifyingIDRPayoutSig),
 		"payoutID", id,
 		"transactionID", transactionID,
-		"accountNumber", s.client.LogAccountNumber(accountNumber),
+		"accountNumber", c.LogAccountNumber(accountNumber),
 		"amount", amount,
 	)
 
 	// Check required fields
 	if id == "" {
-		s.client.Logger().Warn(s.client.I18n(i18n.LogIDRPayoutSigFailedMissing), "field", "id")
-		return s.client.Error(errors.ErrMissingCallbackField, "id")
+		c.Logger().Warn(c.I18n(i18n.LogIDRPayoutSigFailedMissing), "field", "id")
+		return c.Error(errors.ErrMissingCallbackField, "id")
 	}
 	if accountNumber == "" {
-		s.client.Logger().Warn(s.client.I18n(i18n.LogIDRPayoutSigFailedMissing), "field", "account_number")
-		return s.client.Error(errors.ErrMissingCallbackField, "account_number")
+		c.Logger().Warn(c.I18n(i18n.LogIDRPayoutSigFailedMissing), "field", "account_number")
+		return c.Error(errors.ErrMissingCallbackField, "account_number")
 	}
 	if amount == "" {
-		s.client.Logger().Warn(s.client.I18n(i18n.LogIDRPayoutSigFailedMissing), "field", "amount")
-		return s.client.Error(errors.ErrMissingCallbackField, "amount")
+		c.Logger().Warn(c.I18n(i18n.LogIDRPayoutSigFailedMissing), "field", "amount")
+		return c.Error(errors.ErrMissingCallbackField, "amount")
 	}
 	if transactionID == "" {
-		s.client.Logger().Warn(s.client.I18n(i18n.LogIDRPayoutSigFailedMissing), "field", "transaction_id")
-		return s.client.Error(errors.ErrMissingCallbackField, "transaction_id")
+		c.Logger().Warn(c.I18n(i18n.LogIDRPayoutSigFailedMissing), "field", "transaction_id")
+		return c.Error(errors.ErrMissingCallbackField, "transaction_id")
 	}
 	if receivedSignature == "" {
-		s.client.Logger().Warn(s.client.I18n(i18n.LogIDRPayoutSigFailedMissing), "field", "signature")
-		return s.client.Error(errors.ErrMissingCallbackField, "signature")
+		c.Logger().Warn(c.I18n(i18n.LogIDRPayoutSigFailedMissing), "field", "signature")
+		return c.Error(errors.ErrMissingCallbackField, "signature")
 	}
 
 	// Format amount with 2 decimal places
-	formattedAmount, err := amountfmt.Format(amount, s.client.Language)
+	formattedAmount, err := amountfmt.Format(amount, c.Language)
 	if err != nil {
-		s.client.Logger().Warn(s.client.I18n(i18n.LogIDRPayoutSigFailedFormat),
+		c.Logger().Warn(c.I18n(i18n.LogIDRPayoutSigFailedFormat),
 			"amount", amount,
 			"error", err.Error(),
 		)
 		return err
 	}
 
-	// Generate expected signature
+	// Build signature data (each accepted secret key is appended when matching)
 	// Formula: MD5(id + account_number + amount + transaction_id + operator_secret_key)
-	signatureData := fmt.Sprintf("%s%s%s%s%s",
+	signatureData := fmt.Sprintf("%s%s%s%s",
 		id,
 		accountNumber,
 		formattedAmount,
 		transactionID,
-		s.client.SecretKey,
 	)
-	expectedSignature := s.client.GenerateSignature(signatureData)
 
-	// Constant-time comparison to prevent timing attacks
-	if !s.client.VerifySignature(expectedSignature, receivedSignature) {
-		s.client.Logger().Warn(s.client.I18n(i18n.LogIDRPayoutSigFailedMismatch),
+	// Constant-time comparison against every accepted secret key
+	_, ok, err := c.MatchSignature(signatureData, receivedSignature)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		c.Logger().Warn(c.I18n(i18n.LogIDRPayoutSigFailedMismatch),
 			"payoutID", id,
 			"transactionID", transactionID,
 		)
-		return s.client.Error(errors.ErrInvalidSignature)
+		return c.Error(errors.ErrInvalidSignature)
 	}
 
-	s.client.Logger().Debug(s.client.I18n(i18n.LogIDRPayoutSigVerified),
+	c.Logger().Debug(c.I18n(i18n.LogIDRPayoutSigVerified),
 		"payoutID", id,
 		"transactionID", transactionID,
 	)
 	return nil
 }
 
+// VerifyStatusSignature verifies the signature of an IDR payout status response.
+//
+// Status Signature formula: MD5(idrpayout_id + account_number + amount + transaction_id + operator_secret_key)
+// Note: Amount in status response has 2 decimal places (e.g., "10000.00").
+func (s *IDRService) VerifyStatusSignature(status *IDRStatusResponse, opts ...client.CallOption) error {
+	c := s.client.WithCallOptions(opts...)
+	c.Logger().Debug(c.I18n(i18n.LogVerifyingIDRPayoutStatusSig),
+		"payoutID", status.IDRPayoutID,
+		"transactionID", status.TransactionID,
+	)
+
+	if err := s.VerifySignature(
+		string(status.IDRPayoutID),
+		status.AccountNumber,
+		string(status.Amount),
+		status.TransactionID,
+		status.Signature,
+		opts...,
+	); err != nil {
+		return err
+	}
+
+	c.Logger().Info(c.I18n(i18n.LogIDRPayoutStatusSigVerified),
+		"payoutID", status.IDRPayoutID,
+		"transactionID", status.TransactionID,
+	)
+	return nil
+}
+
 // VerifyCallback verifies the signature of an IDR payout callback.
 //
 // Callback Signature formula: MD5(idrpayout_id + account_number + amount + transaction_id + operator_secret_key)
@@ -314,7 +457,7 @@ func (s *IDRService) VerifySignature(id, accountNumber, amount, transactionID, r
 //
 // This method only verifies the signature. To also verify the source IP,
 // use [IDRService.VerifyCallbackWithIP] instead.
-func (s *IDRService) VerifyCallback(callback *IDRCallback) error {
+func (s *IDRService) VerifyCallback(callback *IDRCallback, opts ...client.CallOption) error {
 	// Delegate to VerifySignature which handles all logging
 	return s.VerifySignature(
 		string(callback.IDRPayoutID),
@@ -322,6 +465,7 @@ func (s *IDRService) VerifyCallback(callback *IDRCallback) error {
 		string(callback.Amount),
 		callback.TransactionID,
 		callback.Signature,
+		opts...,
 	)
 }
 
@@ -333,16 +477,17 @@ func (s *IDRService) VerifyCallback(callback *IDRCallback) error {
 // If the client was configured with [WithCallbackIPWhitelist], this method will
 // verify that the source IP is in the whitelist before verifying the signature.
 // If no whitelist was configured, IP verification is skipped.
-func (s *IDRService) VerifyCallbackWithIP(callback *IDRCallback, sourceIP string) error {
-	s.client.Logger().Debug(s.client.I18n(i18n.LogVerifyingIDRPayoutCallback),
+func (s *IDRService) VerifyCallbackWithIP(callback *IDRCallback, sourceIP string, opts ...client.CallOption) error {
+	c := s.client.WithCallOptions(opts...)
+	c.Logger().Debug(c.I18n(i18n.LogVerifyingIDRPayoutCallback),
 		"transactionID", callback.TransactionID,
 		"payoutID", callback.IDRPayoutID,
 		"sourceIP", sourceIP,
 	)
 
 	// Verify IP first (fast fail)
-	if err := s.client.VerifyCallbackIP(sourceIP); err != nil {
-		s.client.Logger().Warn(s.client.I18n(i18n.LogIDRPayoutCallbackIPFailed),
+	if err := c.VerifyCallbackIP(sourceIP); err != nil {
+		c.Logger().Warn(c.I18n(i18n.LogIDRPayoutCallbackIPFailed),
 			"sourceIP", sourceIP,
 			"error", err.Error(),
 		)
@@ -350,11 +495,11 @@ func (s *IDRService) VerifyCallbackWithIP(callback *IDRCallback, sourceIP string
 	}
 
 	// Then verify signature (VerifySignature handles failure logging)
-	if err := s.VerifyCallback(callback); err != nil {
+	if err := s.VerifyCallback(callback, opts...); err != nil {
 		return err
 	}
 
-	s.client.Logger().Info(s.client.I18n(i18n.LogIDRPayoutCallbackVerified),
+	c.Logger().Info(c.I18n(i18n.LogIDRPayoutCallbackVerified),
 		"transactionID", callback.TransactionID,
 		"payoutID", callback.IDRPayoutID,
 		"completed", callback.Completed,
@@ -362,3 +507,67 @@ func (s *IDRService) VerifyCallbackWithIP(callback *IDRCallback, sourceIP string
 	)
 	return nil
 }
+
+// VerifyCallbackBytes decodes a raw IDR payout callback body and verifies it.
+//
+// The body is decoded with [client.Client.DecodeCallback], so numbers keep
+// the exact form GSPAY2 signed and the size and strictness settings of the
+// client apply. If [client.WithCallbackSourceIP] is given, the source IP is
+// verified as in [IDRService.VerifyCallbackWithIP]; otherwise only the signature
+// is verified.
+//
+// Example:
+//
+//	callback, err := svc.VerifyCallbackBytes(body, client.WithCallbackSourceIP(ip))
+//	if err != nil {
+//	    // Reject the callback
+//	}
+func (s *IDRService) VerifyCallbackBytes(body []byte, opts ...client.CallOption) (*IDRCallback, error) {
+	c := s.client.WithCallOptions(opts...)
+	var callback IDRCallback
+	if err := c.DecodeCallback(body, &callback); err != nil {
+		return nil, err
+	}
+
+	var err error
+	if sourceIP, ok := c.CallbackSourceIP(); ok {
+		err = s.VerifyCallbackWithIP(&callback, sourceIP, opts...)
+	} else {
+		err = s.VerifyCallback(&callback, opts...)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &callback, nil
+}
+
+// VerifyCallbackRequest reads, decodes and verifies a IDR payout callback
+// request in one step: body size, JSON, source IP and signature.
+//
+// The source IP is [http.Request.RemoteAddr]. Behind a reverse proxy, pass
+// the client IP it resolved with [client.WithCallbackSourceIP].
+//
+// Example:
+//
+//	http.HandleFunc("POST /callback", func(w http.ResponseWriter, r *http.Request) {
+//	    callback, err := svc.VerifyCallbackRequest(r)
+//	    if err != nil {
+//	        http.Error(w, "invalid callback", http.StatusBadRequest)
+//	        return
+//	    }
+//	    // Process callback
+//	    w.Write([]byte("OK"))
+//	})
+func (s *IDRService) VerifyCallbackRequest(r *http.Request, opts ...client.CallOption) (*IDRCallback, error) {
+	body, err := s.client.WithCallOptions(opts...).ReadCallback(r)
+	if err != nil {
+		return nil, err
+	}
+	return s.VerifyCallbackBytes(body, append([]client.CallOption{client.WithCallbackSourceIP(r.RemoteAddr)}, opts...)...)
+}
+
+// MaxCallbackSize returns the largest IDR payout callback body the service
+// accepts, set by [client.WithMaxCallbackSize].
+func (s *IDRService) MaxCallbackSize() int64 {
+	return s.client.MaxCallbackSize()
+}
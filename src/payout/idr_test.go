@@ -26,6 +26,7 @@ import (
 	"github.com/H0llyW00dzZ/gspay-go-sdk/src/client"
 	"github.com/H0llyW00dzZ/gspay-go-sdk/src/constants"
 	"github.com/H0llyW00dzZ/gspay-go-sdk/src/errors"
+	"github.com/H0llyW00dzZ/gspay-go-sdk/src/i18n"
 	"github.com/H0llyW00dzZ/gspay-go-sdk/src/internal/signature"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -241,6 +242,101 @@ func TestIDRService_Create(t *testing.T) {
 	})
 }
 
+func TestIDRRequest_Validate(t *testing.T) {
+	valid := func() *IDRRequest {
+		return &IDRRequest{
+			TransactionID: "TXN123456789",
+			Username:      "user123",
+			AccountName:   "John Doe",
+			AccountNumber: "1234567890",
+			Amount:        50000,
+			BankCode:      "bca",
+		}
+	}
+
+	t.Run("valid request", func(t *testing.T) {
+		assert.NoError(t, valid().Validate())
+	})
+
+	tests := []struct {
+		name     string
+		modify   func(r *IDRRequest)
+		field    string
+		sentinel error
+	}{
+		{"transaction ID characters", func(r *IDRRequest) { r.TransactionID = "TXN 123/456" }, "transaction_id", errors.ErrInvalidTransactionID},
+		{"empty username", func(r *IDRRequest) { r.Username = " " }, "username", errors.ErrInvalidUsername},
+		{"empty account name", func(r *IDRRequest) { r.AccountName = "" }, "account_name", errors.ErrInvalidAccountName},
+		{"non-numeric account number", func(r *IDRRequest) { r.AccountNumber = "1234-5678" }, "account_number", errors.ErrInvalidAccountNumber},
+		{"empty account number", func(r *IDRRequest) { r.AccountNumber = "" }, "account_number", errors.ErrInvalidAccountNumber},
+		{"account number too short for bank", func(r *IDRRequest) { r.AccountNumber = "12345" }, "account_number", errors.ErrInvalidAccountNumber},
+		{"e-wallet needs phone number", func(r *IDRRequest) { r.BankCode = "DANA"; r.AccountNumber = "1234567890" }, "account_number", errors.ErrInvalidAccountNumber},
+		{"unknown bank", func(r *IDRRequest) { r.BankCode = "XYZ" }, "bank_code", errors.ErrInvalidBankCode},
+		{"amount too small", func(r *IDRRequest) { r.Amount = 100 }, "amount", errors.ErrInvalidAmount},
+		{"description too long", func(r *IDRRequest) { r.Description = strings.Repeat("x", constants.MaxDescriptionLength+1) }, "description", errors.ErrInvalidDescription},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := valid()
+			tt.modify(req)
+			errs := errors.GetValidationErrors(req.Validate())
+			require.Len(t, errs, 1)
+			assert.Equal(t, tt.field, errs[0].Field)
+			assert.ErrorIs(t, errs, tt.sentinel)
+		})
+	}
+
+	t.Run("reports every failing field localized", func(t *testing.T) {
+		svc := NewIDRService(client.New("auth-key", "secret-key", client.WithLanguage(i18n.Indonesian)))
+		_, err := svc.Create(t.Context(), &IDRRequest{TransactionID: "TX", BankCode: "XYZ", Amount: 1})
+		errs := errors.GetValidationErrors(err)
+		fields := make([]string, len(errs))
+		for i, e := range errs {
+			fields[i] = e.Field
+			assert.Equal(t, i18n.Indonesian, e.Lang)
+		}
+		assert.Equal(t, []string{"transaction_id", "username", "account_name", "bank_code", "account_number", "amount"}, fields)
+		assert.Equal(t, i18n.Get(i18n.Indonesian, i18n.MsgInvalidUsername), errs.Field("username").Message)
+	})
+
+	t.Run("bank name resolves to code", func(t *testing.T) {
+		req := valid()
+		req.BankCode = "bank mandiri"
+		req.AccountNumber = "1234567890123"
+		assert.NoError(t, req.Validate())
+	})
+
+	t.Run("bank not enabled for payout", func(t *testing.T) {
+		orig, ok := constants.LookupBank(constants.CurrencyIDR, "BCA")
+		require.True(t, ok)
+		t.Cleanup(func() { constants.RegisterBank(orig) })
+
+		disabled := orig
+		disabled.Payout = false
+		constants.RegisterBank(disabled)
+
+		errs := errors.GetValidationErrors(valid().Validate())
+		require.Len(t, errs, 1)
+		assert.ErrorIs(t, errs, errors.ErrInvalidBankCode)
+	})
+
+	t.Run("validate lang localizes every failing field", func(t *testing.T) {
+		req := valid()
+		req.BankCode = "XYZ"
+		errs := errors.GetValidationErrors(req.ValidateLang(i18n.Indonesian))
+		require.NotNil(t, errs.Field("bank_code"))
+		assert.Equal(t, "XYZ: "+i18n.Get(i18n.Indonesian, i18n.MsgInvalidBankCode), errs.Field("bank_code").Message)
+	})
+
+	t.Run("create returns all failures", func(t *testing.T) {
+		svc := NewIDRService(client.New("auth-key", "secret-key"))
+		_, err := svc.Create(t.Context(), &IDRRequest{TransactionID: "TXN123456789", BankCode: "BCA", Amount: 50000})
+		errs := errors.GetValidationErrors(err)
+		assert.Len(t, errs, 3)
+		assert.ErrorIs(t, err, errors.ErrInvalidAccountName)
+	})
+}
+
 func TestIDRService_GetStatus(t *testing.T) {
 	t.Run("gets payout status successfully", func(t *testing.T) {
 		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -274,6 +370,87 @@ func TestIDRService_GetStatus(t *testing.T) {
 		assert.Equal(t, "success", resp.Remark)
 		assert.Equal(t, "sig", resp.Signature)
 	})
+
+	t.Run("returns error on missing data", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			json.NewEncoder(w).Encode(map[string]any{
+				"code":    200,
+				"message": "success",
+			})
+		}))
+		defer server.Close()
+
+		c := client.New("auth-key", "secret-key", client.WithBaseURL(server.URL))
+		svc := NewIDRService(c)
+
+		resp, err := svc.GetStatus(t.Context(), "TXN123456789")
+		assert.Nil(t, resp)
+		assert.ErrorIs(t, err, errors.ErrEmptyResponse)
+	})
+}
+
+func TestIDRService_VerifyStatusSignature(t *testing.T) {
+	c := client.New("auth-key", "test-secret-key")
+	svc := NewIDRService(c)
+
+	t.Run("verifies valid status signature", func(t *testing.T) {
+		status := &IDRStatusResponse{
+			IDRPayoutID:   "123",
+			TransactionID: "TXN123456789",
+			AccountName:   "John Doe",
+			AccountNumber: "1234567890",
+			Amount:        "50000.00",
+			Status:        constants.StatusSuccess,
+			Completed:     true,
+			PayoutSuccess: true,
+		}
+		// Generate correct signature
+		status.Signature = signature.Generate("123123456789050000.00TXN123456789test-secret-key")
+
+		err := svc.VerifyStatusSignature(status)
+		assert.NoError(t, err)
+	})
+
+	t.Run("rejects invalid status signature", func(t *testing.T) {
+		status := &IDRStatusResponse{
+			IDRPayoutID:   "123",
+			TransactionID: "TXN123456789",
+			AccountNumber: "1234567890",
+			Amount:        "50000.00",
+			Signature:     "invalid",
+		}
+
+		err := svc.VerifyStatusSignature(status)
+		assert.ErrorIs(t, err, errors.ErrInvalidSignature)
+	})
+}
+
+func TestPaymentStatus(t *testing.T) {
+	tests := []struct {
+		name      string
+		status    constants.PaymentStatus
+		completed bool
+		success   bool
+		want      constants.PaymentStatus
+	}{
+		{"incomplete keeps reported status", constants.StatusPending, false, false, constants.StatusPending},
+		{"completed and successful", constants.StatusPending, true, true, constants.StatusSuccess},
+		{"completed but unsuccessful", constants.StatusPending, true, false, constants.StatusFailed},
+		{"completed keeps reported failure", constants.StatusTimeout, true, false, constants.StatusTimeout},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			status := &IDRStatusResponse{Status: tt.status, Completed: tt.completed, PayoutSuccess: tt.success}
+			assert.Equal(t, tt.want, status.PaymentStatus())
+		})
+	}
+
+	t.Run("callback without completion is pending", func(t *testing.T) {
+		assert.Equal(t, constants.StatusPending, (&IDRCallback{}).PaymentStatus())
+		assert.Equal(t, constants.StatusSuccess, (&IDRCallback{Completed: true, PayoutSuccess: true}).PaymentStatus())
+	})
 }
 
 func TestIDRService_VerifyCallback(t *testing.T) {
@@ -473,6 +650,43 @@ func TestIDRService_VerifyCallback_JSONDecode(t *testing.T) {
 	}
 }
 
+func TestIDRService_VerifyCallbackBytes(t *testing.T) {
+	sig := signature.Generate("123123456789050000.50TXN123456789secret-key")
+	payload := fmt.Sprintf(`{"idrpayout_id":123,"transaction_id":"TXN123456789","account_name":"John Doe","account_number":"1234567890","amount":50000.50,"completed":true,"payout_success":true,"remark":"","signature":%q}`, sig)
+
+	t.Run("decodes with number preservation and verifies", func(t *testing.T) {
+		svc := NewIDRService(client.New("auth-key", "secret-key"))
+
+		callback, err := svc.VerifyCallbackBytes([]byte(payload))
+		require.NoError(t, err)
+		assert.Equal(t, json.Number("123"), callback.IDRPayoutID)
+		assert.Equal(t, json.Number("50000.50"), callback.Amount)
+		assert.True(t, callback.PayoutSuccess)
+	})
+
+	t.Run("strict mode rejects duplicate keys", func(t *testing.T) {
+		svc := NewIDRService(client.New("auth-key", "secret-key", client.WithStrictCallbacks(true)))
+
+		_, err := svc.VerifyCallbackBytes([]byte(strings.Replace(payload, `"completed":true`, `"completed":true,"completed":false`, 1)))
+		assert.ErrorIs(t, err, errors.ErrDuplicateKey)
+	})
+
+	t.Run("request verifies IP and signature", func(t *testing.T) {
+		svc := NewIDRService(client.New("auth-key", "secret-key", client.WithCallbackIPWhitelist("192.168.1.1")))
+
+		r := httptest.NewRequest(http.MethodPost, "/callback", strings.NewReader(payload))
+		r.RemoteAddr = "192.168.1.1:40000"
+		callback, err := svc.VerifyCallbackRequest(r)
+		require.NoError(t, err)
+		assert.Equal(t, "1234567890", callback.AccountNumber)
+
+		r = httptest.NewRequest(http.MethodPost, "/callback", strings.NewReader(payload))
+		r.RemoteAddr = "10.0.0.1:40000"
+		_, err = svc.VerifyCallbackRequest(r)
+		assert.ErrorIs(t, err, errors.ErrIPNotWhitelisted)
+	})
+}
+
 func TestIDRService_VerifyCallbackWithIP(t *testing.T) {
 	t.Run("verifies callback with whitelisted IP", func(t *testing.T) {
 		c := client.New("auth-key", "secret-key", client.WithCallbackIPWhitelist("192.168.1.1"))
@@ -0,0 +1,65 @@
+// Copyright 2026 H0llyW00dzZ
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package reconcile compares locally recorded transactions with GSPAY2.
+//
+// Each [Transaction] carries the status and amount the merchant expects.
+// A [Reconciler] queries GSPAY2 for every transaction with bounded
+// concurrency, verifies the status signatures, and collects any
+// discrepancies into a [Report].
+//
+// # Basic Usage
+//
+//	c := client.New("auth-key", "secret-key")
+//	r := reconcile.New(
+//	    reconcile.WithPaymentIDR(payment.NewIDRService(c)),
+//	    reconcile.WithPayoutIDR(payout.NewIDRService(c)),
+//	    reconcile.WithConcurrency(8),
+//	)
+//
+//	report, err := r.Run(ctx, []reconcile.Transaction{
+//	    {Kind: ledger.KindPaymentIDR, TransactionID: "TXN123456789", ExpectedStatus: constants.StatusPending, ExpectedAmount: "50000"},
+//	})
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	for _, d := range report.Diffs {
+//	    fmt.Printf("%s: %s\n", d.TransactionID, d.Type)
+//	}
+//
+// # Using the Ledger
+//
+// Records from the [ledger] package can be converted directly:
+//
+//	recs, err := l.PendingLongerThan(ctx, 30*time.Minute)
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	report, err := r.Run(ctx, reconcile.FromLedger(recs))
+//
+// # Diff Types
+//
+// The report lists one [Diff] per discrepancy:
+//   - [DiffStatusMismatch]: GSPAY2 reports a different status
+//   - [DiffAmountMismatch]: GSPAY2 reports a different amount
+//   - [DiffUnknownToGSPay]: GSPAY2 answered that the transaction was not found
+//   - [DiffCompletedUnrecorded]: GSPAY2 reports a final status for a transaction still pending locally
+//   - [DiffInvalidSignature]: The status response signature could not be verified
+//   - [DiffQueryFailed]: The status query failed (network, server errors, empty responses)
+//   - [DiffUnsupported]: No status endpoint is available for the transaction kind
+//
+// # Export
+//
+// Reports can be exported with [Report.WriteJSON] or [Report.WriteCSV].
+package reconcile
@@ -0,0 +1,289 @@
+// Copyright 2026 H0llyW00dzZ
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package reconcile
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/H0llyW00dzZ/gspay-go-sdk/src/client"
+	"github.com/H0llyW00dzZ/gspay-go-sdk/src/constants"
+	"github.com/H0llyW00dzZ/gspay-go-sdk/src/errors"
+	amountfmt "github.com/H0llyW00dzZ/gspay-go-sdk/src/helper/amount"
+	"github.com/H0llyW00dzZ/gspay-go-sdk/src/i18n"
+	"github.com/H0llyW00dzZ/gspay-go-sdk/src/ledger"
+	"github.com/H0llyW00dzZ/gspay-go-sdk/src/payment"
+	"github.com/H0llyW00dzZ/gspay-go-sdk/src/payout"
+)
+
+// DefaultConcurrency is the default number of concurrent status queries.
+const DefaultConcurrency = 4
+
+// Transaction is a locally recorded transaction to reconcile.
+type Transaction struct {
+	// Kind is the transaction kind.
+	Kind ledger.Kind `json:"kind"`
+	// TransactionID is the merchant transaction ID.
+	TransactionID string `json:"transaction_id"`
+	// ExpectedStatus is the status recorded locally.
+	ExpectedStatus constants.PaymentStatus `json:"expected_status"`
+	// ExpectedAmount is the amount recorded locally (optional).
+	// Amounts are compared after formatting to 2 decimal places.
+	ExpectedAmount string `json:"expected_amount,omitempty"`
+}
+
+// FromLedger converts ledger records into transactions to reconcile.
+func FromLedger(recs []*ledger.Record) []Transaction {
+	txs := make([]Transaction, 0, len(recs))
+	for _, rec := range recs {
+		txs = append(txs, Transaction{
+			Kind:           rec.Kind,
+			TransactionID:  rec.TransactionID,
+			ExpectedStatus: rec.Status,
+			ExpectedAmount: rec.Amount,
+		})
+	}
+	return txs
+}
+
+// Reconciler queries GSPAY2 for the status of local transactions.
+type Reconciler struct {
+	paymentIDR  *payment.IDRService
+	payoutIDR   *payout.IDRService
+	concurrency int
+	callOpts    []client.CallOption
+	lang        i18n.Language
+	now         func() time.Time
+}
+
+// Option is a functional option for configuring the [Reconciler].
+type Option func(*Reconciler)
+
+// WithPaymentIDR sets the service used to query IDR payment status.
+// Without it, [ledger.KindPaymentIDR] transactions are reported as [DiffUnsupported].
+func WithPaymentIDR(svc *payment.IDRService) Option {
+	return func(r *Reconciler) {
+		r.paymentIDR = svc
+	}
+}
+
+// WithPayoutIDR sets the service used to query IDR payout status.
+// Without it, [ledger.KindPayoutIDR] transactions are reported as [DiffUnsupported].
+func WithPayoutIDR(svc *payout.IDRService) Option {
+	return func(r *Reconciler) {
+		r.payoutIDR = svc
+	}
+}
+
+// WithConcurrency sets the maximum number of concurrent status queries.
+// Default is [DefaultConcurrency]. Values less than 1 are ignored.
+func WithConcurrency(n int) Option {
+	return func(r *Reconciler) {
+		if n > 0 {
+			r.concurrency = n
+		}
+	}
+}
+
+// WithCallOptions sets call options applied to every status query.
+//
+// Example:
+//
+//	// Fail fast: 2s per status query, no retries
+//	reconcile.WithCallOptions(client.WithCallTimeout(2*time.Second), client.WithCallRetries(0))
+func WithCallOptions(opts ...client.CallOption) Option {
+	return func(r *Reconciler) {
+		r.callOpts = append(r.callOpts, opts...)
+	}
+}
+
+// WithLanguage sets the language for localized error messages in the report.
+// Default is [i18n.English].
+func WithLanguage(lang i18n.Language) Option {
+	return func(r *Reconciler) {
+		if lang.IsValid() {
+			r.lang = lang
+		}
+	}
+}
+
+// WithClock sets the function used to timestamp reports.
+// Default is [time.Now]. This is mainly useful for tests.
+func WithClock(now func() time.Time) Option {
+	return func(r *Reconciler) {
+		if now != nil {
+			r.now = now
+		}
+	}
+}
+
+// New creates a new [Reconciler].
+func New(opts ...Option) *Reconciler {
+	r := &Reconciler{
+		concurrency: DefaultConcurrency,
+		lang:        i18n.English,
+		now:         time.Now,
+	}
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
+// Run reconciles txs against GSPAY2 and returns the resulting report.
+//
+// Per-transaction failures are recorded as diffs rather than returned.
+// If ctx is canceled, the partial report is returned together with ctx.Err().
+func (r *Reconciler) Run(ctx context.Context, txs []Transaction) (*Report, error) {
+	report := &Report{StartedAt: r.now(), Diffs: []Diff{}}
+	results := make([][]Diff, len(txs))
+
+	sem := make(chan struct{}, r.concurrency)
+	var wg sync.WaitGroup
+	for i, tx := range txs {
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+		}
+		if ctx.Err() != nil {
+			break
+		}
+		report.Checked++
+		wg.Go(func() {
+			defer func() { <-sem }()
+			results[i] = r.check(ctx, tx)
+		})
+	}
+	wg.Wait()
+
+	for _, diffs := range results[:report.Checked] {
+		if len(diffs) == 0 {
+			report.Matched++
+		}
+		report.Diffs = append(report.Diffs, diffs...)
+	}
+	report.FinishedAt = r.now()
+	return report, ctx.Err()
+}
+
+// observed is the normalized status reported by GSPAY2.
+type observed struct {
+	providerID string
+	status     constants.PaymentStatus
+	amount     string
+}
+
+// check reconciles a single transaction.
+func (r *Reconciler) check(ctx context.Context, tx Transaction) []Diff {
+	base := Diff{
+		TransactionID:  tx.TransactionID,
+		Kind:           tx.Kind,
+		ExpectedStatus: tx.ExpectedStatus,
+		ExpectedAmount: tx.ExpectedAmount,
+	}
+
+	obs, err := r.query(ctx, tx)
+	if err != nil {
+		base.Type = classify(err)
+		base.Error = err.Error()
+		return []Diff{base}
+	}
+	base.ProviderID = obs.providerID
+	base.ActualStatus = &obs.status
+	base.ActualAmount = obs.amount
+
+	var diffs []Diff
+	switch {
+	case tx.ExpectedStatus.IsPending() && !obs.status.IsPending():
+		base.Type = DiffCompletedUnrecorded
+		diffs = append(diffs, base)
+	case tx.ExpectedStatus != obs.status:
+		base.Type = DiffStatusMismatch
+		diffs = append(diffs, base)
+	}
+	if tx.ExpectedAmount != "" && !r.sameAmount(tx.ExpectedAmount, obs.amount) {
+		base.Type = DiffAmountMismatch
+		diffs = append(diffs, base)
+	}
+	return diffs
+}
+
+// query fetches and verifies the GSPAY2 status of tx.
+func (r *Reconciler) query(ctx context.Context, tx Transaction) (*observed, error) {
+	switch {
+	case tx.Kind == ledger.KindPaymentIDR && r.paymentIDR != nil:
+		status, err := r.paymentIDR.GetStatus(ctx, tx.TransactionID, r.callOpts...)
+		if err != nil {
+			return nil, err
+		}
+		if err := r.paymentIDR.VerifyStatusSignature(status, r.callOpts...); err != nil {
+			return nil, err
+		}
+		return &observed{
+			providerID: string(status.IDRPaymentID),
+			status:     status.Status,
+			amount:     string(status.Amount),
+		}, nil
+	case tx.Kind == ledger.KindPayoutIDR && r.payoutIDR != nil:
+		status, err := r.payoutIDR.GetStatus(ctx, tx.TransactionID, r.callOpts...)
+		if err != nil {
+			return nil, err
+		}
+		if err := r.payoutIDR.VerifyStatusSignature(status, r.callOpts...); err != nil {
+			return nil, err
+		}
+		return &observed{
+			providerID: string(status.IDRPayoutID),
+			status:     status.PaymentStatus(),
+			amount:     string(status.Amount),
+		}, nil
+	default:
+		return nil, errors.New(r.lang, errors.ErrUnsupportedKind, string(tx.Kind))
+	}
+}
+
+// sameAmount reports whether two amount strings are equal to 2 decimal places.
+func (r *Reconciler) sameAmount(expected, actual string) bool {
+	e, err := amountfmt.Format(expected, r.lang)
+	if err != nil {
+		return false
+	}
+	a, err := amountfmt.Format(actual, r.lang)
+	if err != nil {
+		return false
+	}
+	return e == a
+}
+
+// classify maps a query error to a [DiffType].
+func classify(err error) DiffType {
+	switch {
+	case errors.Is(err, errors.ErrUnsupportedKind):
+		return DiffUnsupported
+	case errors.Is(err, errors.ErrInvalidSignature),
+		errors.Is(err, errors.ErrMissingCallbackField):
+		return DiffInvalidSignature
+	}
+	// Only a definitive not-found answer means GSPAY2 has no record; an empty
+	// response is a transient server problem and is reported as a failed query.
+	if apiErr := errors.GetAPIError(err); apiErr != nil {
+		if apiErr.Code == 404 || strings.Contains(strings.ToLower(apiErr.Message), "not found") {
+			return DiffUnknownToGSPay
+		}
+	}
+	return DiffQueryFailed
+}
@@ -0,0 +1,237 @@
+// Copyright 2026 H0llyW00dzZ
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package reconcile
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/H0llyW00dzZ/gspay-go-sdk/src/client"
+	"github.com/H0llyW00dzZ/gspay-go-sdk/src/constants"
+	"github.com/H0llyW00dzZ/gspay-go-sdk/src/internal/signature"
+	"github.com/H0llyW00dzZ/gspay-go-sdk/src/ledger"
+	"github.com/H0llyW00dzZ/gspay-go-sdk/src/payment"
+	"github.com/H0llyW00dzZ/gspay-go-sdk/src/payout"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testSecret = "test-secret-key"
+
+// gspayState is the status GSPAY2 reports for a transaction in the fake server.
+type gspayState struct {
+	status    constants.PaymentStatus
+	amount    string
+	completed bool
+	badSig    bool
+}
+
+// newFakeGSPay starts a server that answers IDR payment and payout status queries.
+// Transactions missing from states are reported as not found.
+func newFakeGSPay(t *testing.T, states map[string]gspayState) (*httptest.Server, *atomic.Int32) {
+	t.Helper()
+	var inFlight, peak atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := inFlight.Add(1)
+		defer inFlight.Add(-1)
+		for {
+			p := peak.Load()
+			if n <= p || peak.CompareAndSwap(p, n) {
+				break
+			}
+		}
+		time.Sleep(5 * time.Millisecond)
+
+		txID := r.URL.Query().Get("transaction_id")
+		w.Header().Set("Content-Type", "application/json")
+
+		if txID == "TXN-EMPTY" {
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+		if txID == "TXN-BROKEN" {
+			json.NewEncoder(w).Encode(map[string]any{"code": 500, "message": "internal error"})
+			return
+		}
+		st, ok := states[txID]
+		if !ok {
+			json.NewEncoder(w).Encode(map[string]any{"code": 404, "message": "transaction not found"})
+			return
+		}
+
+		var data map[string]any
+		if strings.Contains(r.URL.Path, "/payout/") {
+			sig := signature.Generate("99" + "1234567890" + st.amount + txID + testSecret)
+			data = map[string]any{
+				"idrpayout_id":   99,
+				"transaction_id": txID,
+				"account_number": "1234567890",
+				"amount":         json.Number(st.amount),
+				"status":         st.status,
+				"completed":      st.completed,
+				"payout_success": st.status.IsSuccess(),
+				"signature":      sig,
+			}
+		} else {
+			sig := signature.Generate(fmt.Sprintf("123%s%s%d%s", st.amount, txID, st.status, testSecret))
+			data = map[string]any{
+				"idrpayment_id":  123,
+				"transaction_id": txID,
+				"amount":         json.Number(st.amount),
+				"status":         st.status,
+				"completed":      st.completed,
+				"signature":      sig,
+			}
+		}
+		if st.badSig {
+			data["signature"] = "invalid"
+		}
+		encoded, _ := json.Marshal(data)
+		json.NewEncoder(w).Encode(map[string]any{"code": 200, "message": "success", "data": string(encoded)})
+	}))
+	t.Cleanup(server.Close)
+	return server, &peak
+}
+
+func newTestReconciler(server *httptest.Server, opts ...Option) *Reconciler {
+	c := client.New("auth-key", testSecret, client.WithBaseURL(server.URL), client.WithRetries(0))
+	opts = append([]Option{
+		WithPaymentIDR(payment.NewIDRService(c)),
+		WithPayoutIDR(payout.NewIDRService(c)),
+	}, opts...)
+	return New(opts...)
+}
+
+func TestReconciler_Run(t *testing.T) {
+	server, _ := newFakeGSPay(t, map[string]gspayState{
+		"TXN-MATCH":       {status: constants.StatusSuccess, amount: "50000.00", completed: true},
+		"TXN-COMPLETED":   {status: constants.StatusSuccess, amount: "50000.00", completed: true},
+		"TXN-STATUS":      {status: constants.StatusFailed, amount: "50000.00", completed: true},
+		"TXN-AMOUNT":      {status: constants.StatusSuccess, amount: "49000.00", completed: true},
+		"TXN-BADSIG":      {status: constants.StatusSuccess, amount: "50000.00", completed: true, badSig: true},
+		"PAYOUT-COMPLETE": {status: constants.StatusSuccess, amount: "75000.00", completed: true},
+	})
+	r := newTestReconciler(server)
+
+	txs := []Transaction{
+		{Kind: ledger.KindPaymentIDR, TransactionID: "TXN-MATCH", ExpectedStatus: constants.StatusSuccess, ExpectedAmount: "50000"},
+		{Kind: ledger.KindPaymentIDR, TransactionID: "TXN-COMPLETED", ExpectedStatus: constants.StatusPending, ExpectedAmount: "50000"},
+		{Kind: ledger.KindPaymentIDR, TransactionID: "TXN-STATUS", ExpectedStatus: constants.StatusSuccess},
+		{Kind: ledger.KindPaymentIDR, TransactionID: "TXN-AMOUNT", ExpectedStatus: constants.StatusSuccess, ExpectedAmount: "50000"},
+		{Kind: ledger.KindPaymentIDR, TransactionID: "TXN-BADSIG", ExpectedStatus: constants.StatusSuccess},
+		{Kind: ledger.KindPaymentIDR, TransactionID: "TXN-MISSING", ExpectedStatus: constants.StatusPending},
+		{Kind: ledger.KindPaymentIDR, TransactionID: "TXN-BROKEN", ExpectedStatus: constants.StatusPending},
+		{Kind: ledger.KindPaymentIDR, TransactionID: "TXN-EMPTY", ExpectedStatus: constants.StatusPending},
+		{Kind: ledger.KindPayoutIDR, TransactionID: "PAYOUT-COMPLETE", ExpectedStatus: constants.StatusPending, ExpectedAmount: "75000"},
+		{Kind: ledger.KindPaymentUSDT, TransactionID: "USDT-1", ExpectedStatus: constants.StatusPending},
+	}
+
+	report, err := r.Run(t.Context(), txs)
+	require.NoError(t, err)
+	assert.Equal(t, len(txs), report.Checked)
+	assert.Equal(t, 1, report.Matched)
+	assert.True(t, report.HasDiffs())
+	assert.False(t, report.FinishedAt.Before(report.StartedAt))
+
+	got := make(map[string]DiffType)
+	for _, d := range report.Diffs {
+		got[d.TransactionID] = d.Type
+	}
+	assert.Equal(t, map[string]DiffType{
+		"TXN-COMPLETED":   DiffCompletedUnrecorded,
+		"TXN-STATUS":      DiffStatusMismatch,
+		"TXN-AMOUNT":      DiffAmountMismatch,
+		"TXN-BADSIG":      DiffInvalidSignature,
+		"TXN-MISSING":     DiffUnknownToGSPay,
+		"TXN-BROKEN":      DiffQueryFailed,
+		"TXN-EMPTY":       DiffQueryFailed,
+		"PAYOUT-COMPLETE": DiffCompletedUnrecorded,
+		"USDT-1":          DiffUnsupported,
+	}, got)
+
+	t.Run("diffs follow input order", func(t *testing.T) {
+		require.Len(t, report.Diffs, 9)
+		assert.Equal(t, "TXN-COMPLETED", report.Diffs[0].TransactionID)
+		assert.Equal(t, "USDT-1", report.Diffs[8].TransactionID)
+	})
+
+	t.Run("diff carries actual values", func(t *testing.T) {
+		d := report.ByType(DiffAmountMismatch)
+		require.Len(t, d, 1)
+		assert.Equal(t, "123", d[0].ProviderID)
+		assert.Equal(t, "49000.00", d[0].ActualAmount)
+		require.NotNil(t, d[0].ActualStatus)
+		assert.Equal(t, constants.StatusSuccess, *d[0].ActualStatus)
+
+		d = report.ByType(DiffUnknownToGSPay)
+		require.Len(t, d, 1)
+		assert.Nil(t, d[0].ActualStatus)
+		assert.Contains(t, d[0].Error, "not found")
+	})
+}
+
+func TestReconciler_Concurrency(t *testing.T) {
+	states := make(map[string]gspayState)
+	var txs []Transaction
+	for i := range 20 {
+		id := fmt.Sprintf("TXN-%02d", i)
+		states[id] = gspayState{status: constants.StatusSuccess, amount: "10000.00", completed: true}
+		txs = append(txs, Transaction{Kind: ledger.KindPaymentIDR, TransactionID: id, ExpectedStatus: constants.StatusSuccess})
+	}
+	server, peak := newFakeGSPay(t, states)
+	r := newTestReconciler(server, WithConcurrency(3), WithCallOptions(client.WithCallTimeout(2*time.Second)))
+
+	report, err := r.Run(t.Context(), txs)
+	require.NoError(t, err)
+	assert.Equal(t, 20, report.Matched)
+	assert.Empty(t, report.Diffs)
+	assert.LessOrEqual(t, peak.Load(), int32(3))
+}
+
+func TestReconciler_Canceled(t *testing.T) {
+	server, _ := newFakeGSPay(t, nil)
+	r := newTestReconciler(server)
+
+	ctx, cancel := context.WithCancel(t.Context())
+	cancel()
+
+	report, err := r.Run(ctx, []Transaction{{Kind: ledger.KindPaymentIDR, TransactionID: "TXN-1"}})
+	assert.ErrorIs(t, err, context.Canceled)
+	require.NotNil(t, report)
+	assert.Zero(t, report.Checked)
+}
+
+func TestFromLedger(t *testing.T) {
+	l := ledger.New(ledger.NewMemoryStore())
+	req := &payment.IDRRequest{TransactionID: "TXN123456789", Username: "user123", Amount: 50000}
+	require.NoError(t, l.RecordIDRPayment(t.Context(), req, &payment.IDRResponse{IDRPaymentID: "PAY123"}, nil))
+
+	recs, err := l.List(t.Context(), nil)
+	require.NoError(t, err)
+
+	assert.Equal(t, []Transaction{{
+		Kind:           ledger.KindPaymentIDR,
+		TransactionID:  "TXN123456789",
+		ExpectedStatus: constants.StatusPending,
+		ExpectedAmount: "50000",
+	}}, FromLedger(recs))
+}
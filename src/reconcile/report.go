@@ -0,0 +1,149 @@
+// Copyright 2026 H0llyW00dzZ
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package reconcile
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/H0llyW00dzZ/gspay-go-sdk/src/constants"
+	"github.com/H0llyW00dzZ/gspay-go-sdk/src/ledger"
+)
+
+// DiffType identifies the kind of discrepancy found during reconciliation.
+type DiffType string
+
+const (
+	// DiffStatusMismatch indicates GSPAY2 reports a different status than expected.
+	DiffStatusMismatch DiffType = "status_mismatch"
+	// DiffAmountMismatch indicates GSPAY2 reports a different amount than expected.
+	DiffAmountMismatch DiffType = "amount_mismatch"
+	// DiffUnknownToGSPay indicates GSPAY2 has no record of the transaction.
+	DiffUnknownToGSPay DiffType = "unknown_to_gspay"
+	// DiffCompletedUnrecorded indicates GSPAY2 reports a final status
+	// for a transaction that is still pending locally.
+	DiffCompletedUnrecorded DiffType = "completed_unrecorded"
+	// DiffInvalidSignature indicates the status response signature is invalid.
+	DiffInvalidSignature DiffType = "invalid_signature"
+	// DiffQueryFailed indicates the status query failed.
+	DiffQueryFailed DiffType = "query_failed"
+	// DiffUnsupported indicates no status query is available for the transaction kind.
+	DiffUnsupported DiffType = "unsupported"
+)
+
+// Diff describes a single discrepancy between a local transaction and GSPAY2.
+type Diff struct {
+	// Type is the kind of discrepancy.
+	Type DiffType `json:"type"`
+	// TransactionID is the merchant transaction ID.
+	TransactionID string `json:"transaction_id"`
+	// Kind is the transaction kind.
+	Kind ledger.Kind `json:"kind"`
+	// ProviderID is the GSPAY2-assigned ID, if the transaction was found.
+	ProviderID string `json:"provider_id,omitempty"`
+	// ExpectedStatus is the locally recorded status.
+	ExpectedStatus constants.PaymentStatus `json:"expected_status"`
+	// ActualStatus is the status reported by GSPAY2, or nil if unavailable.
+	ActualStatus *constants.PaymentStatus `json:"actual_status,omitempty"`
+	// ExpectedAmount is the locally recorded amount.
+	ExpectedAmount string `json:"expected_amount,omitempty"`
+	// ActualAmount is the amount reported by GSPAY2.
+	ActualAmount string `json:"actual_amount,omitempty"`
+	// Error is the query error, if any.
+	Error string `json:"error,omitempty"`
+}
+
+// Report is the result of a reconciliation run.
+type Report struct {
+	// StartedAt is when the run started.
+	StartedAt time.Time `json:"started_at"`
+	// FinishedAt is when the run finished.
+	FinishedAt time.Time `json:"finished_at"`
+	// Checked is the number of transactions checked.
+	Checked int `json:"checked"`
+	// Matched is the number of transactions without discrepancies.
+	Matched int `json:"matched"`
+	// Diffs lists all discrepancies in input order.
+	Diffs []Diff `json:"diffs"`
+}
+
+// HasDiffs reports whether any discrepancies were found.
+func (r *Report) HasDiffs() bool { return len(r.Diffs) > 0 }
+
+// ByType returns the discrepancies of the given type.
+func (r *Report) ByType(t DiffType) []Diff {
+	var diffs []Diff
+	for _, d := range r.Diffs {
+		if d.Type == t {
+			diffs = append(diffs, d)
+		}
+	}
+	return diffs
+}
+
+// WriteJSON writes the report to w as indented JSON.
+func (r *Report) WriteJSON(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(r)
+}
+
+// csvHeader is the header row written by [Report.WriteCSV].
+var csvHeader = []string{
+	"type",
+	"transaction_id",
+	"kind",
+	"provider_id",
+	"expected_status",
+	"actual_status",
+	"expected_amount",
+	"actual_amount",
+	"error",
+}
+
+// WriteCSV writes the discrepancies to w as CSV with a header row.
+//
+// Statuses are written as their numeric codes. The actual status
+// column is empty when GSPAY2 did not report a status.
+func (r *Report) WriteCSV(w io.Writer) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(csvHeader); err != nil {
+		return err
+	}
+	for _, d := range r.Diffs {
+		actual := ""
+		if d.ActualStatus != nil {
+			actual = strconv.Itoa(int(*d.ActualStatus))
+		}
+		if err := cw.Write([]string{
+			string(d.Type),
+			d.TransactionID,
+			string(d.Kind),
+			d.ProviderID,
+			strconv.Itoa(int(d.ExpectedStatus)),
+			actual,
+			d.ExpectedAmount,
+			d.ActualAmount,
+			d.Error,
+		}); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
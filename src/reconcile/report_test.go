@@ -0,0 +1,87 @@
+// Copyright 2026 H0llyW00dzZ
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package reconcile
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/H0llyW00dzZ/gspay-go-sdk/src/constants"
+	"github.com/H0llyW00dzZ/gspay-go-sdk/src/ledger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func sampleReport() *Report {
+	success := constants.StatusSuccess
+	return &Report{
+		StartedAt:  time.Date(2026, 1, 26, 10, 0, 0, 0, time.UTC),
+		FinishedAt: time.Date(2026, 1, 26, 10, 0, 5, 0, time.UTC),
+		Checked:    3,
+		Matched:    1,
+		Diffs: []Diff{
+			{
+				Type:           DiffCompletedUnrecorded,
+				TransactionID:  "TXN1",
+				Kind:           ledger.KindPaymentIDR,
+				ProviderID:     "123",
+				ExpectedStatus: constants.StatusPending,
+				ActualStatus:   &success,
+				ExpectedAmount: "50000",
+				ActualAmount:   "50000.00",
+			},
+			{
+				Type:           DiffUnknownToGSPay,
+				TransactionID:  "TXN2",
+				Kind:           ledger.KindPayoutIDR,
+				ExpectedStatus: constants.StatusPending,
+				Error:          "transaction not found, really",
+			},
+		},
+	}
+}
+
+func TestReport_WriteJSON(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, sampleReport().WriteJSON(&buf))
+
+	var decoded Report
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &decoded))
+	assert.Equal(t, sampleReport(), &decoded)
+	assert.Contains(t, buf.String(), `"type": "completed_unrecorded"`)
+	assert.NotContains(t, buf.String(), `"actual_status": null`)
+}
+
+func TestReport_WriteCSV(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, sampleReport().WriteCSV(&buf))
+
+	rows, err := csv.NewReader(&buf).ReadAll()
+	require.NoError(t, err)
+	require.Len(t, rows, 3)
+	assert.Equal(t, csvHeader, rows[0])
+	assert.Equal(t, []string{"completed_unrecorded", "TXN1", "payment_idr", "123", "0", "1", "50000", "50000.00", ""}, rows[1])
+	assert.Equal(t, []string{"unknown_to_gspay", "TXN2", "payout_idr", "", "0", "", "", "", "transaction not found, really"}, rows[2])
+}
+
+func TestReport_ByType(t *testing.T) {
+	r := sampleReport()
+	assert.Len(t, r.ByType(DiffUnknownToGSPay), 1)
+	assert.Empty(t, r.ByType(DiffAmountMismatch))
+	assert.False(t, (&Report{}).HasDiffs())
+}
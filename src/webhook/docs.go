@@ -0,0 +1,76 @@
+// Copyright 2026 H0llyW00dzZ
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package webhook receives GSPAY2 callbacks.
+//
+// GSPAY2 posts three callback shapes: IDR payments, USDT payments and IDR
+// payouts. Each needs its own decoding and signature check. A [Router]
+// mounts them all under one prefix and dispatches to typed handlers:
+//
+//	rt := webhook.NewRouter(
+//	    webhook.WithRoute(webhook.PaymentIDR(paymentSvc, onPayment)),
+//	    webhook.WithRoute(webhook.PaymentUSDT(usdtSvc, onUSDT)),
+//	    webhook.WithRoute(webhook.PayoutIDR(payoutSvc, onPayout)),
+//	)
+//	http.Handle("/webhook/", rt)
+//
+// The routes above are served at /webhook/payment/idr, /webhook/payment/usdt
+// and /webhook/payout/idr. Callbacks posted to /webhook itself are
+// dispatched by sniffing the payload for idrpayment_id, cryptopayment_id or
+// idrpayout_id, so GSPAY2 can be configured with a single URL.
+//
+// # Lifecycle Events
+//
+// Every verified callback is also normalized into a [lifecycle.Event] and
+// passed to the [Subscriber] set with [WithSubscriber], whatever its type:
+//
+//	webhook.WithSubscriber(func(ctx context.Context, ev lifecycle.Event) error {
+//	    _, err := machines.Get(ev.TransactionID).Apply(ev)
+//	    return err
+//	})
+//
+// # Responses
+//
+// A callback is acknowledged with 200 "OK" only after its handler and the
+// subscriber succeed; any other response makes GSPAY2 redeliver it. Errors
+// are written as RFC 7807 problems (see [errors.Problem]).
+//
+// # Asynchronous Processing
+//
+// Slow handlers risk GSPAY2 timing out and redelivering. With [WithQueue],
+// the router acknowledges a callback as soon as it is verified and stored,
+// and [Router.Run] processes it in the background:
+//
+//	q, err := webhook.OpenFileQueue("gspay-callbacks.wal")
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	rt := webhook.NewRouter(
+//	    webhook.WithQueue(q),
+//	    webhook.WithWorkers(4),
+//	    webhook.WithRoute(webhook.PaymentIDR(paymentSvc, creditWallet)),
+//	)
+//	go rt.Run(ctx)
+//
+// Failed jobs are retried with exponential backoff and buried in the
+// dead-letter store after [WithMaxAttempts] attempts; see [Queue.DeadLetters]
+// and [Queue.Redrive]. [FileQueue] keeps jobs in a write-ahead log, so jobs
+// unfinished at a crash run again after a restart. Delivery is at-least-once:
+// handlers must be idempotent.
+//
+// # Custom Routes
+//
+// New callback types are added with a [Route] that names its path, its
+// identifying field, and how to verify and handle a body.
+package webhook
@@ -0,0 +1,328 @@
+// Copyright 2026 H0llyW00dzZ
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webhook
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sync"
+	"time"
+
+	"github.com/H0llyW00dzZ/gspay-go-sdk/src/errors"
+	"github.com/H0llyW00dzZ/gspay-go-sdk/src/i18n"
+)
+
+// compactMinOps is the number of log entries after which a [FileQueue]
+// considers rewriting its log.
+const compactMinOps = 1024
+
+// walOp identifies a [FileQueue] log entry.
+type walOp string
+
+const (
+	walEnqueue  walOp = "enqueue"
+	walComplete walOp = "complete"
+	walRetry    walOp = "retry"
+	walBury     walOp = "bury"
+	walRedrive  walOp = "redrive"
+)
+
+// walEntry is a single line of the [FileQueue] log.
+type walEntry struct {
+	Op  walOp  `json:"op"`
+	ID  string `json:"id,omitempty"`
+	Job *Job   `json:"job,omitempty"`
+}
+
+// FileQueue is a [Queue] backed by an append-only write-ahead log.
+//
+// Every change is appended to the log as a JSON line and synced to disk
+// before the call returns. On open, the log is replayed; jobs that were
+// claimed but not finished when the process stopped become claimable again.
+// A crash during a write can only truncate the last line, which is ignored.
+// A write that fails while the process keeps running is rolled back.
+//
+// The log is compacted by atomically rewriting it with the live jobs once it
+// has grown well beyond them. Only one process should open a given file at a
+// time.
+type FileQueue struct {
+	mu   sync.Mutex
+	path string
+	file *os.File
+	jobs jobs
+	ops  int
+	// err is set when a failed append could not be rolled back; the log
+	// then ends in a partial line and must be compacted before appending.
+	err error
+}
+
+// OpenFileQueue opens the queue log at path, creating it if needed.
+//
+// Example:
+//
+//	q, err := webhook.OpenFileQueue("/var/lib/myapp/gspay-callbacks.wal")
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	defer q.Close()
+func OpenFileQueue(path string) (*FileQueue, error) {
+	q := &FileQueue{path: path, jobs: newJobs()}
+
+	data, err := os.ReadFile(path)
+	if err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+	if err := q.replay(data); err != nil {
+		return nil, err
+	}
+	// Start from a compact log; this also drops a torn last line.
+	if err := q.compact(); err != nil {
+		return nil, err
+	}
+	return q, nil
+}
+
+// Path returns the path of the log file.
+func (q *FileQueue) Path() string { return q.path }
+
+// Close closes the log file. The queue must not be used afterwards.
+func (q *FileQueue) Close() error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return q.file.Close()
+}
+
+// Enqueue implements [Queue.Enqueue].
+func (q *FileQueue) Enqueue(ctx context.Context, job Job) error {
+	return q.do(ctx, walEntry{Op: walEnqueue, Job: &job}, func() { q.jobs.enqueue(job) })
+}
+
+// Claim implements [Queue.Claim]. Claims are not logged.
+func (q *FileQueue) Claim(ctx context.Context, now time.Time) (*Job, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return q.jobs.claim(now), nil
+}
+
+// Complete implements [Queue.Complete].
+func (q *FileQueue) Complete(ctx context.Context, id string) error {
+	return q.do(ctx, walEntry{Op: walComplete, ID: id}, func() { q.jobs.complete(id) })
+}
+
+// Retry implements [Queue.Retry].
+func (q *FileQueue) Retry(ctx context.Context, job Job) error {
+	return q.do(ctx, walEntry{Op: walRetry, Job: &job}, func() { q.jobs.retry(job) })
+}
+
+// Bury implements [Queue.Bury].
+func (q *FileQueue) Bury(ctx context.Context, job Job) error {
+	return q.do(ctx, walEntry{Op: walBury, Job: &job}, func() { q.jobs.bury(job) })
+}
+
+// DeadLetters implements [Queue.DeadLetters].
+func (q *FileQueue) DeadLetters(ctx context.Context) ([]Job, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return sorted(q.jobs.dead), nil
+}
+
+// Redrive implements [Queue.Redrive].
+func (q *FileQueue) Redrive(ctx context.Context, id string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if _, ok := q.jobs.dead[id]; !ok {
+		return errors.ErrRecordNotFound
+	}
+	if err := q.append(walEntry{Op: walRedrive, ID: id}); err != nil {
+		return err
+	}
+	q.jobs.redrive(id)
+	return nil
+}
+
+// Len returns the number of pending jobs, including claimed ones.
+func (q *FileQueue) Len() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return len(q.jobs.pending)
+}
+
+// do logs e and then applies it to the in-memory state.
+func (q *FileQueue) do(ctx context.Context, e walEntry, apply func()) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if err := q.append(e); err != nil {
+		return err
+	}
+	apply()
+	if q.ops >= compactMinOps && q.ops > 4*(len(q.jobs.pending)+len(q.jobs.dead)) {
+		// The entry is already durable; a failed compaction keeps the old log.
+		_ = q.compact()
+	}
+	return nil
+}
+
+// append writes e to the log and syncs it. If the write or sync fails, the
+// log is truncated back to its previous size so that it never ends in a
+// partial line followed by further entries. The caller must hold q.mu.
+func (q *FileQueue) append(e walEntry) error {
+	if q.err != nil {
+		// Rewriting the log from memory drops the partial line.
+		if err := q.compact(); err != nil {
+			return q.err
+		}
+	}
+	line, err := json.Marshal(e)
+	if err != nil {
+		return err
+	}
+	info, err := q.file.Stat()
+	if err != nil {
+		return err
+	}
+	_, err = q.file.Write(append(line, '\n'))
+	if err == nil {
+		err = q.file.Sync()
+	}
+	if err != nil {
+		if terr := q.file.Truncate(info.Size()); terr != nil {
+			q.err = errors.Join(err, terr)
+			return q.err
+		}
+		return err
+	}
+	q.ops++
+	return nil
+}
+
+// replay rebuilds the in-memory state from log data.
+func (q *FileQueue) replay(data []byte) error {
+	lines := bytes.Split(data, []byte("\n"))
+	for i, line := range lines {
+		if len(bytes.TrimSpace(line)) == 0 {
+			continue
+		}
+		var e walEntry
+		if err := json.Unmarshal(line, &e); err != nil {
+			if i == len(lines)-1 {
+				break // torn write of the last entry
+			}
+			return errors.New(i18n.English, errors.ErrInvalidJSON, fmt.Sprintf("%s line %d", q.path, i+1), err)
+		}
+		switch e.Op {
+		case walEnqueue:
+			q.jobs.enqueue(*e.Job)
+		case walComplete:
+			q.jobs.complete(e.ID)
+		case walRetry:
+			q.jobs.retry(*e.Job)
+		case walBury:
+			q.jobs.bury(*e.Job)
+		case walRedrive:
+			q.jobs.redrive(e.ID)
+		}
+	}
+	return nil
+}
+
+// compact atomically rewrites the log with only the live jobs and reopens
+// it for appending. The caller must hold q.mu or own q exclusively.
+func (q *FileQueue) compact() error {
+	tmp, err := os.CreateTemp(filepath.Dir(q.path), filepath.Base(q.path)+".tmp-*")
+	if err != nil {
+		return err
+	}
+	tmpName := tmp.Name()
+	defer os.Remove(tmpName) // no-op after a successful rename
+
+	w := bufio.NewWriter(tmp)
+	enc := json.NewEncoder(w)
+	ops := 0
+	for _, job := range sorted(q.jobs.pending) {
+		if err := enc.Encode(walEntry{Op: walEnqueue, Job: &job}); err != nil {
+			tmp.Close()
+			return err
+		}
+		ops++
+	}
+	for _, job := range sorted(q.jobs.dead) {
+		if err := enc.Encode(walEntry{Op: walBury, Job: &job}); err != nil {
+			tmp.Close()
+			return err
+		}
+		ops++
+	}
+	if err := w.Flush(); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+
+	// Open before renaming so a failure leaves the current log in use.
+	file, err := os.OpenFile(tmpName, os.O_WRONLY|os.O_APPEND, 0o600)
+	if err != nil {
+		return err
+	}
+	if err := os.Rename(tmpName, q.path); err != nil {
+		file.Close()
+		return err
+	}
+	if q.file != nil {
+		q.file.Close()
+	}
+	q.file = file
+	q.ops = ops
+	q.err = nil
+	// Make the rename itself durable.
+	return syncDir(filepath.Dir(q.path))
+}
+
+// syncDir flushes the directory entry changes of dir to disk. Windows does
+// not support syncing directories, so it is a no-op there.
+func syncDir(dir string) error {
+	if runtime.GOOS == "windows" {
+		return nil
+	}
+	d, err := os.Open(dir)
+	if err != nil {
+		return err
+	}
+	defer d.Close()
+	return d.Sync()
+}
@@ -0,0 +1,231 @@
+// Copyright 2026 H0llyW00dzZ
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webhook
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/H0llyW00dzZ/gspay-go-sdk/src/errors"
+	"github.com/H0llyW00dzZ/gspay-go-sdk/src/lifecycle"
+)
+
+// Job is a verified callback waiting to be processed by the [Router] workers.
+type Job struct {
+	// ID uniquely identifies the job.
+	ID string `json:"id"`
+	// Route is the [Route.Path] of the callback type.
+	Route string `json:"route"`
+	// Body is the verified callback body.
+	Body json.RawMessage `json:"body"`
+	// Event is the normalized event of the callback.
+	Event lifecycle.Event `json:"event"`
+	// Attempts is the number of failed processing attempts.
+	Attempts int `json:"attempts"`
+	// EnqueuedAt is when the callback was received.
+	EnqueuedAt time.Time `json:"enqueued_at"`
+	// NextAttempt is the earliest time the job may be processed again.
+	NextAttempt time.Time `json:"next_attempt,omitzero"`
+	// LastError is the error of the latest failed attempt.
+	LastError string `json:"last_error,omitempty"`
+}
+
+// Queue stores jobs durably between the webhook acknowledgment and their
+// processing.
+//
+// A claimed job that is neither completed, retried nor buried, for example
+// because the process crashed, must be claimable again after a restart. This
+// makes delivery to the handlers at-least-once, so handlers must be
+// idempotent.
+//
+// Built-in implementations:
+//   - [MemoryQueue]: In-memory queue for tests and processes that can lose jobs
+//   - [FileQueue]: Write-ahead log on disk that survives restarts
+type Queue interface {
+	// Enqueue stores a new job. The job must be durable when it returns.
+	Enqueue(ctx context.Context, job Job) error
+	// Claim returns the next job that is due at now and not claimed, or nil
+	// if there is none.
+	Claim(ctx context.Context, now time.Time) (*Job, error)
+	// Complete removes a processed job.
+	Complete(ctx context.Context, id string) error
+	// Retry stores the updated attempt state of a failed job and releases it.
+	Retry(ctx context.Context, job Job) error
+	// Bury moves a job that will not be retried to the dead-letter store.
+	Bury(ctx context.Context, job Job) error
+	// DeadLetters returns the buried jobs, oldest first.
+	DeadLetters(ctx context.Context) ([]Job, error)
+	// Redrive moves a buried job back to the queue with its attempts reset.
+	// Returns an error wrapping [errors.ErrRecordNotFound] if no job is buried under id.
+	Redrive(ctx context.Context, id string) error
+}
+
+// jobs is the in-memory state shared by the built-in [Queue] implementations.
+type jobs struct {
+	pending map[string]*Job
+	claimed map[string]bool
+	dead    map[string]*Job
+}
+
+func newJobs() jobs {
+	return jobs{
+		pending: make(map[string]*Job),
+		claimed: make(map[string]bool),
+		dead:    make(map[string]*Job),
+	}
+}
+
+func (js jobs) enqueue(job Job) {
+	delete(js.dead, job.ID)
+	js.pending[job.ID] = &job
+}
+
+func (js jobs) claim(now time.Time) *Job {
+	var next *Job
+	for id, job := range js.pending {
+		if js.claimed[id] || now.Before(job.NextAttempt) {
+			continue
+		}
+		if next == nil || job.NextAttempt.Before(next.NextAttempt) ||
+			(job.NextAttempt.Equal(next.NextAttempt) && job.EnqueuedAt.Before(next.EnqueuedAt)) {
+			next = job
+		}
+	}
+	if next == nil {
+		return nil
+	}
+	js.claimed[next.ID] = true
+	job := *next
+	return &job
+}
+
+func (js jobs) complete(id string) {
+	delete(js.pending, id)
+	delete(js.claimed, id)
+}
+
+func (js jobs) retry(job Job) {
+	delete(js.claimed, job.ID)
+	if _, ok := js.pending[job.ID]; ok {
+		js.pending[job.ID] = &job
+	}
+}
+
+func (js jobs) bury(job Job) {
+	js.complete(job.ID)
+	js.dead[job.ID] = &job
+}
+
+func (js jobs) redrive(id string) (Job, bool) {
+	job, ok := js.dead[id]
+	if !ok {
+		return Job{}, false
+	}
+	redriven := *job
+	redriven.Attempts = 0
+	redriven.NextAttempt = time.Time{}
+	redriven.LastError = ""
+	js.enqueue(redriven)
+	return redriven, true
+}
+
+// sorted returns copies of the jobs in m, oldest first.
+func sorted(m map[string]*Job) []Job {
+	list := make([]Job, 0, len(m))
+	for _, job := range m {
+		list = append(list, *job)
+	}
+	slices.SortFunc(list, func(a, b Job) int {
+		return cmp.Or(a.EnqueuedAt.Compare(b.EnqueuedAt), cmp.Compare(a.ID, b.ID))
+	})
+	return list
+}
+
+// MemoryQueue is an in-memory [Queue].
+//
+// Jobs are lost when the process exits, so the webhook acknowledgment does
+// not guarantee processing. Use [FileQueue] or a custom [Queue] in production.
+type MemoryQueue struct {
+	mu   sync.Mutex
+	jobs jobs
+}
+
+// NewMemoryQueue creates an empty [MemoryQueue].
+func NewMemoryQueue() *MemoryQueue { return &MemoryQueue{jobs: newJobs()} }
+
+// Enqueue implements [Queue.Enqueue].
+func (q *MemoryQueue) Enqueue(ctx context.Context, job Job) error {
+	return q.do(ctx, func() error { q.jobs.enqueue(job); return nil })
+}
+
+// Claim implements [Queue.Claim].
+func (q *MemoryQueue) Claim(ctx context.Context, now time.Time) (*Job, error) {
+	var job *Job
+	err := q.do(ctx, func() error { job = q.jobs.claim(now); return nil })
+	return job, err
+}
+
+// Complete implements [Queue.Complete].
+func (q *MemoryQueue) Complete(ctx context.Context, id string) error {
+	return q.do(ctx, func() error { q.jobs.complete(id); return nil })
+}
+
+// Retry implements [Queue.Retry].
+func (q *MemoryQueue) Retry(ctx context.Context, job Job) error {
+	return q.do(ctx, func() error { q.jobs.retry(job); return nil })
+}
+
+// Bury implements [Queue.Bury].
+func (q *MemoryQueue) Bury(ctx context.Context, job Job) error {
+	return q.do(ctx, func() error { q.jobs.bury(job); return nil })
+}
+
+// DeadLetters implements [Queue.DeadLetters].
+func (q *MemoryQueue) DeadLetters(ctx context.Context) ([]Job, error) {
+	var list []Job
+	err := q.do(ctx, func() error { list = sorted(q.jobs.dead); return nil })
+	return list, err
+}
+
+// Redrive implements [Queue.Redrive].
+func (q *MemoryQueue) Redrive(ctx context.Context, id string) error {
+	return q.do(ctx, func() error {
+		if _, ok := q.jobs.redrive(id); !ok {
+			return errors.ErrRecordNotFound
+		}
+		return nil
+	})
+}
+
+// Len returns the number of pending jobs, including claimed ones.
+func (q *MemoryQueue) Len() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return len(q.jobs.pending)
+}
+
+// do runs fn under the queue lock unless ctx is done.
+func (q *MemoryQueue) do(ctx context.Context, fn func() error) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return fn()
+}
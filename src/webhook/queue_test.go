@@ -0,0 +1,273 @@
+// Copyright 2026 H0llyW00dzZ
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webhook
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/H0llyW00dzZ/gspay-go-sdk/src/errors"
+	"github.com/H0llyW00dzZ/gspay-go-sdk/src/lifecycle"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testJob(id string, enqueuedAt time.Time) Job {
+	return Job{
+		ID:         id,
+		Route:      "payment/idr",
+		Body:       []byte(`{"idrpayment_id":123,"amount":"50000.00"}`),
+		Event:      lifecycle.Event{Kind: lifecycle.KindPaymentIDR, State: lifecycle.StateSucceeded, TransactionID: "TXN123456789"},
+		EnqueuedAt: enqueuedAt,
+	}
+}
+
+func TestQueues(t *testing.T) {
+	base := time.Date(2026, 1, 26, 15, 0, 0, 0, time.UTC)
+
+	for name, open := range map[string]func(t *testing.T) Queue{
+		"memory": func(t *testing.T) Queue { return NewMemoryQueue() },
+		"file": func(t *testing.T) Queue {
+			q, err := OpenFileQueue(filepath.Join(t.TempDir(), "queue.wal"))
+			require.NoError(t, err)
+			t.Cleanup(func() { q.Close() })
+			return q
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			t.Run("claims due jobs oldest first", func(t *testing.T) {
+				q := open(t)
+				ctx := t.Context()
+				require.NoError(t, q.Enqueue(ctx, testJob("b", base.Add(time.Second))))
+				require.NoError(t, q.Enqueue(ctx, testJob("a", base)))
+
+				job, err := q.Claim(ctx, base)
+				require.NoError(t, err)
+				require.NotNil(t, job)
+				assert.Equal(t, "a", job.ID)
+				assert.JSONEq(t, `{"idrpayment_id":123,"amount":"50000.00"}`, string(job.Body))
+
+				job, err = q.Claim(ctx, base)
+				require.NoError(t, err)
+				assert.Equal(t, "b", job.ID)
+
+				job, err = q.Claim(ctx, base)
+				require.NoError(t, err)
+				assert.Nil(t, job, "both jobs are claimed")
+
+				require.NoError(t, q.Complete(ctx, "a"))
+				require.NoError(t, q.Complete(ctx, "a"), "completing twice is a no-op")
+			})
+
+			t.Run("retries wait for their next attempt", func(t *testing.T) {
+				q := open(t)
+				ctx := t.Context()
+				require.NoError(t, q.Enqueue(ctx, testJob("a", base)))
+				job, err := q.Claim(ctx, base)
+				require.NoError(t, err)
+
+				job.Attempts = 1
+				job.LastError = "wallet unavailable"
+				job.NextAttempt = base.Add(time.Minute)
+				require.NoError(t, q.Retry(ctx, *job))
+
+				job, err = q.Claim(ctx, base.Add(30*time.Second))
+				require.NoError(t, err)
+				assert.Nil(t, job)
+
+				job, err = q.Claim(ctx, base.Add(time.Minute))
+				require.NoError(t, err)
+				require.NotNil(t, job)
+				assert.Equal(t, 1, job.Attempts)
+				assert.Equal(t, "wallet unavailable", job.LastError)
+			})
+
+			t.Run("buries and redrives dead letters", func(t *testing.T) {
+				q := open(t)
+				ctx := t.Context()
+				require.NoError(t, q.Enqueue(ctx, testJob("a", base)))
+				job, err := q.Claim(ctx, base)
+				require.NoError(t, err)
+				job.Attempts = 3
+				job.LastError = "boom"
+				require.NoError(t, q.Bury(ctx, *job))
+
+				dead, err := q.DeadLetters(ctx)
+				require.NoError(t, err)
+				require.Len(t, dead, 1)
+				assert.Equal(t, "boom", dead[0].LastError)
+
+				job, err = q.Claim(ctx, base.Add(time.Hour))
+				require.NoError(t, err)
+				assert.Nil(t, job)
+
+				require.NoError(t, q.Redrive(ctx, "a"))
+				assert.ErrorIs(t, q.Redrive(ctx, "a"), errors.ErrRecordNotFound)
+
+				job, err = q.Claim(ctx, base)
+				require.NoError(t, err)
+				require.NotNil(t, job)
+				assert.Zero(t, job.Attempts)
+				assert.Empty(t, job.LastError)
+
+				dead, err = q.DeadLetters(ctx)
+				require.NoError(t, err)
+				assert.Empty(t, dead)
+			})
+
+			t.Run("honors context cancellation", func(t *testing.T) {
+				q := open(t)
+				ctx, cancel := context.WithCancel(t.Context())
+				cancel()
+				assert.Error(t, q.Enqueue(ctx, testJob("a", base)))
+				_, err := q.Claim(ctx, base)
+				assert.Error(t, err)
+			})
+		})
+	}
+}
+
+func TestFileQueue(t *testing.T) {
+	base := time.Date(2026, 1, 26, 15, 0, 0, 0, time.UTC)
+
+	t.Run("survives a restart", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "queue.wal")
+		q, err := OpenFileQueue(path)
+		require.NoError(t, err)
+		assert.Equal(t, path, q.Path())
+		ctx := t.Context()
+
+		for _, id := range []string{"done", "claimed", "retried", "dead"} {
+			require.NoError(t, q.Enqueue(ctx, testJob(id, base)))
+		}
+		require.NoError(t, q.Complete(ctx, "done"))
+		_, err = q.Claim(ctx, base) // claimed, never finished
+		require.NoError(t, err)
+		retried := testJob("retried", base)
+		retried.Attempts = 2
+		require.NoError(t, q.Retry(ctx, retried))
+		require.NoError(t, q.Bury(ctx, testJob("dead", base)))
+		require.NoError(t, q.Close())
+
+		q, err = OpenFileQueue(path)
+		require.NoError(t, err)
+		defer q.Close()
+		assert.Equal(t, 2, q.Len())
+
+		var ids []string
+		for {
+			job, err := q.Claim(ctx, base)
+			require.NoError(t, err)
+			if job == nil {
+				break
+			}
+			ids = append(ids, job.ID)
+			if job.ID == "retried" {
+				assert.Equal(t, 2, job.Attempts)
+			}
+		}
+		assert.ElementsMatch(t, []string{"claimed", "retried"}, ids)
+
+		dead, err := q.DeadLetters(ctx)
+		require.NoError(t, err)
+		require.Len(t, dead, 1)
+		assert.Equal(t, "dead", dead[0].ID)
+	})
+
+	t.Run("ignores a torn last entry", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "queue.wal")
+		q, err := OpenFileQueue(path)
+		require.NoError(t, err)
+		require.NoError(t, q.Enqueue(t.Context(), testJob("a", base)))
+		require.NoError(t, q.Close())
+
+		f, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND, 0o600)
+		require.NoError(t, err)
+		_, err = f.WriteString(`{"op":"complete","id":"a`)
+		require.NoError(t, err)
+		require.NoError(t, f.Close())
+
+		q, err = OpenFileQueue(path)
+		require.NoError(t, err)
+		defer q.Close()
+		assert.Equal(t, 1, q.Len())
+	})
+
+	t.Run("recovers from a failed append", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "queue.wal")
+		q, err := OpenFileQueue(path)
+		require.NoError(t, err)
+		defer q.Close()
+		ctx := t.Context()
+		require.NoError(t, q.Enqueue(ctx, testJob("a", base)))
+
+		// A read-only handle makes both the write and the rollback fail.
+		writable := q.file
+		q.file, err = os.Open(path)
+		require.NoError(t, err)
+		require.NoError(t, writable.Close())
+
+		assert.Error(t, q.Enqueue(ctx, testJob("b", base)))
+		assert.Equal(t, 1, q.Len())
+		assert.Error(t, q.err)
+
+		// The next append rewrites the log before writing.
+		require.NoError(t, q.Enqueue(ctx, testJob("c", base)))
+		assert.NoError(t, q.err)
+		require.NoError(t, q.Close())
+
+		q, err = OpenFileQueue(path)
+		require.NoError(t, err)
+		defer q.Close()
+		assert.Equal(t, 2, q.Len())
+	})
+
+	t.Run("rejects a corrupt log", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "queue.wal")
+		require.NoError(t, os.WriteFile(path, []byte("garbage\n{\"op\":\"complete\",\"id\":\"a\"}\n"), 0o600))
+		_, err := OpenFileQueue(path)
+		assert.ErrorIs(t, err, errors.ErrInvalidJSON)
+	})
+
+	t.Run("compacts the log", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "queue.wal")
+		q, err := OpenFileQueue(path)
+		require.NoError(t, err)
+		defer q.Close()
+		ctx := t.Context()
+
+		for i := range compactMinOps {
+			id := "job-" + strings.Repeat("x", i%7) + time.Duration(i).String()
+			require.NoError(t, q.Enqueue(ctx, testJob(id, base)))
+			require.NoError(t, q.Complete(ctx, id))
+		}
+		require.NoError(t, q.Enqueue(ctx, testJob("live", base)))
+
+		data, err := os.ReadFile(path)
+		require.NoError(t, err)
+		lines := strings.Count(string(data), "\n")
+		assert.Less(t, lines, compactMinOps, "log was rewritten")
+
+		job, err := q.Claim(ctx, base)
+		require.NoError(t, err)
+		require.NotNil(t, job)
+		assert.Equal(t, "live", job.ID)
+	})
+}
@@ -0,0 +1,164 @@
+// Copyright 2026 H0llyW00dzZ
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/H0llyW00dzZ/gspay-go-sdk/src/client"
+	"github.com/H0llyW00dzZ/gspay-go-sdk/src/constants"
+	"github.com/H0llyW00dzZ/gspay-go-sdk/src/errors"
+	"github.com/H0llyW00dzZ/gspay-go-sdk/src/i18n"
+	"github.com/H0llyW00dzZ/gspay-go-sdk/src/lifecycle"
+	"github.com/H0llyW00dzZ/gspay-go-sdk/src/payment"
+	"github.com/H0llyW00dzZ/gspay-go-sdk/src/payout"
+)
+
+// Route handles one GSPAY2 callback type.
+//
+// The built-in routes are created with [PaymentIDR], [PaymentUSDT] and
+// [PayoutIDR]. Other callback types, such as a new currency, can be added by
+// filling in a Route and passing it to [WithRoute].
+type Route struct {
+	// Path is the route path under the router prefix, e.g. "payment/idr".
+	Path string
+	// Field is a top-level JSON field that only this callback type carries.
+	// It identifies the type when callbacks share one URL.
+	Field string
+	// MaxBodySize caps the size of a callback body in bytes. The built-in
+	// routes use the limit of the service's client (see
+	// [client.WithMaxCallbackSize]). Zero means
+	// [constants.DefaultMaxCallbackSize].
+	MaxBodySize int64
+	// Verify decodes body, verifies its source IP and signature, and returns
+	// the normalized event and the decoded callback. The router fills in the
+	// event time. The built-in routes use the service's VerifyCallbackBytes,
+	// so the strictness settings of the client apply, and report errors in
+	// the router language.
+	Verify func(body []byte, sourceIP string, lang i18n.Language) (lifecycle.Event, any, error)
+	// Decode decodes a body that passed Verify when it was queued (see
+	// [WithQueue]). Verify already rejected anything strict decoding would,
+	// so a plain decode yields the same callback.
+	Decode func(body []byte) (any, error)
+	// Handle runs the typed handler with a callback returned by Verify or
+	// Decode.
+	Handle func(ctx context.Context, callback any) error
+}
+
+// PaymentIDR returns the route for IDR payment callbacks, mounted at
+// "payment/idr" and detected by "idrpayment_id". fn may be nil when only the
+// lifecycle subscriber is needed.
+func PaymentIDR(svc *payment.IDRService, fn func(ctx context.Context, callback *payment.IDRCallback) error) Route {
+	return Route{
+		Path:        "payment/idr",
+		Field:       "idrpayment_id",
+		MaxBodySize: svc.MaxCallbackSize(),
+		Verify: func(body []byte, sourceIP string, lang i18n.Language) (lifecycle.Event, any, error) {
+			callback, err := svc.VerifyCallbackBytes(body,
+				client.WithCallbackSourceIP(sourceIP),
+				client.WithCallLanguage(lang),
+			)
+			if err != nil {
+				return lifecycle.Event{}, nil, err
+			}
+			return lifecycle.FromIDRPaymentCallback(callback, time.Time{}), callback, nil
+		},
+		Decode: decoder[payment.IDRCallback],
+		Handle: handler(fn),
+	}
+}
+
+// PaymentUSDT returns the route for USDT payment callbacks, mounted at
+// "payment/usdt" and detected by "cryptopayment_id". fn may be nil.
+func PaymentUSDT(svc *payment.USDTService, fn func(ctx context.Context, callback *payment.USDTCallback) error) Route {
+	return Route{
+		Path:        "payment/usdt",
+		Field:       "cryptopayment_id",
+		MaxBodySize: svc.MaxCallbackSize(),
+		Verify: func(body []byte, sourceIP string, lang i18n.Language) (lifecycle.Event, any, error) {
+			callback, err := svc.VerifyCallbackBytes(body,
+				client.WithCallbackSourceIP(sourceIP),
+				client.WithCallLanguage(lang),
+			)
+			if err != nil {
+				return lifecycle.Event{}, nil, err
+			}
+			return lifecycle.FromUSDTPaymentCallback(callback, time.Time{}), callback, nil
+		},
+		Decode: decoder[payment.USDTCallback],
+		Handle: handler(fn),
+	}
+}
+
+// PayoutIDR returns the route for IDR payout callbacks, mounted at
+// "payout/idr" and detected by "idrpayout_id". fn may be nil.
+func PayoutIDR(svc *payout.IDRService, fn func(ctx context.Context, callback *payout.IDRCallback) error) Route {
+	return Route{
+		Path:        "payout/idr",
+		Field:       "idrpayout_id",
+		MaxBodySize: svc.MaxCallbackSize(),
+		Verify: func(body []byte, sourceIP string, lang i18n.Language) (lifecycle.Event, any, error) {
+			callback, err := svc.VerifyCallbackBytes(body,
+				client.WithCallbackSourceIP(sourceIP),
+				client.WithCallLanguage(lang),
+			)
+			if err != nil {
+				return lifecycle.Event{}, nil, err
+			}
+			return lifecycle.FromIDRPayoutCallback(callback, time.Time{}), callback, nil
+		},
+		Decode: decoder[payout.IDRCallback],
+		Handle: handler(fn),
+	}
+}
+
+// maxBodySize returns the body size limit of the route.
+func (r *Route) maxBodySize() int64 {
+	if r.MaxBodySize > 0 {
+		return r.MaxBodySize
+	}
+	return constants.DefaultMaxCallbackSize
+}
+
+// handler adapts a typed callback handler to [Route.Handle].
+func handler[T any](fn func(ctx context.Context, callback *T) error) func(ctx context.Context, callback any) error {
+	return func(ctx context.Context, callback any) error {
+		if fn == nil {
+			return nil
+		}
+		typed, ok := callback.(*T)
+		if !ok {
+			return errors.New(i18n.English, errors.ErrUnknownCallback, fmt.Sprintf("%T", callback))
+		}
+		return fn(ctx, typed)
+	}
+}
+
+// decoder is a [Route.Decode] for callbacks of type T. It keeps numbers as
+// sent by GSPAY2. The body has already passed [Route.Verify], so a failure
+// here is a programming error and is reported in English.
+func decoder[T any](body []byte) (any, error) {
+	var callback T
+	dec := json.NewDecoder(bytes.NewReader(body))
+	dec.UseNumber()
+	if err := dec.Decode(&callback); err != nil {
+		return nil, errors.New(i18n.English, errors.ErrInvalidJSON, err)
+	}
+	return &callback, nil
+}
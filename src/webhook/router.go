@@ -0,0 +1,311 @@
+// Copyright 2026 H0llyW00dzZ
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/H0llyW00dzZ/gspay-go-sdk/src/constants"
+	"github.com/H0llyW00dzZ/gspay-go-sdk/src/errors"
+	"github.com/H0llyW00dzZ/gspay-go-sdk/src/i18n"
+	"github.com/H0llyW00dzZ/gspay-go-sdk/src/lifecycle"
+)
+
+// Subscriber receives the normalized lifecycle event of every verified
+// callback, whatever its type.
+type Subscriber func(ctx context.Context, ev lifecycle.Event) error
+
+// Router serves every GSPAY2 callback type under one prefix.
+//
+// Each route is mounted at prefix + "/" + [Route.Path]. Callbacks posted to
+// the prefix itself are dispatched by sniffing the payload for
+// [Route.Field], so GSPAY2 can be configured with a single URL.
+type Router struct {
+	prefix      string
+	routes      []Route
+	subscriber  Subscriber
+	clientIP    func(r *http.Request) string
+	maxBodySize int64 // largest Route.MaxBodySize
+	lang        i18n.Language
+	now         func() time.Time
+
+	queue        Queue
+	workers      int
+	maxAttempts  int
+	retryWaitMin time.Duration
+	retryWaitMax time.Duration
+	pollInterval time.Duration
+	onError      func(job Job, err error, dead bool)
+	wake         chan struct{}
+}
+
+// Option is a functional option for configuring the [Router].
+type Option func(*Router)
+
+// WithPrefix sets the URL path prefix the router is mounted at.
+// Default is "/webhook".
+func WithPrefix(prefix string) Option {
+	return func(rt *Router) {
+		rt.prefix = "/" + strings.Trim(prefix, "/")
+	}
+}
+
+// WithRoute adds a callback route. A route with the same path as an earlier
+// one replaces it.
+//
+// Example:
+//
+//	webhook.WithRoute(webhook.PaymentIDR(paymentSvc, func(ctx context.Context, cb *payment.IDRCallback) error {
+//	    return orders.MarkPaid(ctx, cb.TransactionID)
+//	}))
+func WithRoute(route Route) Option {
+	return func(rt *Router) {
+		for i, existing := range rt.routes {
+			if existing.Path == route.Path {
+				rt.routes[i] = route
+				return
+			}
+		}
+		rt.routes = append(rt.routes, route)
+	}
+}
+
+// WithSubscriber sets a function that receives the [lifecycle.Event] of every
+// verified callback after its typed handler has run.
+func WithSubscriber(fn Subscriber) Option {
+	return func(rt *Router) { rt.subscriber = fn }
+}
+
+// WithClientIP sets the function that extracts the source IP of a callback,
+// e.g. from X-Forwarded-For behind a trusted proxy. Default is
+// [http.Request.RemoteAddr].
+func WithClientIP(fn func(r *http.Request) string) Option {
+	return func(rt *Router) {
+		if fn != nil {
+			rt.clientIP = fn
+		}
+	}
+}
+
+// WithLanguage sets the language of error responses.
+// Default is [i18n.English].
+func WithLanguage(lang i18n.Language) Option {
+	return func(rt *Router) {
+		if lang.IsValid() {
+			rt.lang = lang
+		}
+	}
+}
+
+// WithClock sets the function used to timestamp events.
+// Default is [time.Now]. This is mainly useful for tests.
+func WithClock(now func() time.Time) Option {
+	return func(rt *Router) {
+		if now != nil {
+			rt.now = now
+		}
+	}
+}
+
+// NewRouter creates a [Router].
+//
+// Example:
+//
+//	rt := webhook.NewRouter(
+//	    webhook.WithRoute(webhook.PaymentIDR(paymentSvc, onPayment)),
+//	    webhook.WithRoute(webhook.PaymentUSDT(usdtSvc, onUSDT)),
+//	    webhook.WithRoute(webhook.PayoutIDR(payoutSvc, onPayout)),
+//	    webhook.WithSubscriber(func(ctx context.Context, ev lifecycle.Event) error {
+//	        log.Printf("%s %s is %s", ev.Kind, ev.TransactionID, ev.State)
+//	        return nil
+//	    }),
+//	)
+//	http.Handle("/webhook/", rt)
+func NewRouter(opts ...Option) *Router {
+	rt := &Router{
+		prefix:   "/webhook",
+		clientIP: func(r *http.Request) string { return r.RemoteAddr },
+		lang:     i18n.English,
+		now:      time.Now,
+
+		workers:      DefaultWorkers,
+		maxAttempts:  DefaultMaxAttempts,
+		retryWaitMin: DefaultRetryWaitMin,
+		retryWaitMax: DefaultRetryWaitMax,
+		pollInterval: DefaultPollInterval,
+		wake:         make(chan struct{}, 1),
+	}
+	for _, opt := range opts {
+		opt(rt)
+	}
+	for i := range rt.routes {
+		rt.maxBodySize = max(rt.maxBodySize, rt.routes[i].maxBodySize())
+	}
+	if rt.maxBodySize == 0 { // no routes
+		rt.maxBodySize = constants.DefaultMaxCallbackSize
+	}
+	return rt
+}
+
+// ServeHTTP implements [http.Handler].
+//
+// A callback is acknowledged with 200 "OK" only after its handler and the
+// subscriber have succeeded, so GSPAY2 redelivers it otherwise. With
+// [WithQueue], it is acknowledged once stored in the queue instead. Errors are
+// written as RFC 7807 problems: 400 for malformed or unrecognized payloads,
+// 401 for a bad signature, 403 for a source IP outside the whitelist, 404
+// for an unknown path, 413 for an oversized body, and 500 for handler errors.
+func (rt *Router) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+	path, ok := rt.relativePath(r.URL.Path)
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, rt.maxBodySize))
+	if err != nil {
+		rt.writeError(w, r, err)
+		return
+	}
+
+	route, err := rt.match(path, body)
+	if err != nil {
+		rt.writeError(w, r, err)
+		return
+	}
+	if route == nil {
+		http.NotFound(w, r)
+		return
+	}
+	if limit := route.maxBodySize(); int64(len(body)) > limit {
+		rt.writeError(w, r, errors.New(rt.lang, errors.ErrCallbackTooLarge, strconv.FormatInt(limit, 10)+" bytes"))
+		return
+	}
+
+	ev, callback, err := route.Verify(body, rt.clientIP(r), rt.lang)
+	if err != nil {
+		rt.writeError(w, r, err)
+		return
+	}
+	ev.At = rt.now()
+
+	if rt.queue != nil {
+		if err := rt.enqueue(r.Context(), route, body, ev); err != nil {
+			rt.writeError(w, r, err)
+			return
+		}
+		writeOK(w)
+		return
+	}
+
+	if err := route.Handle(r.Context(), callback); err != nil {
+		rt.writeError(w, r, err)
+		return
+	}
+	if rt.subscriber != nil {
+		if err := rt.subscriber(r.Context(), ev); err != nil {
+			rt.writeError(w, r, err)
+			return
+		}
+	}
+
+	writeOK(w)
+}
+
+// writeOK acknowledges a callback.
+func writeOK(w http.ResponseWriter) {
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	_, _ = io.WriteString(w, "OK")
+}
+
+// relativePath strips the prefix from path. It reports false if path is
+// outside the prefix.
+func (rt *Router) relativePath(path string) (string, bool) {
+	rel, ok := strings.CutPrefix(path, rt.prefix)
+	if !ok || (rel != "" && rt.prefix != "/" && rel[0] != '/') {
+		return "", false
+	}
+	return strings.Trim(rel, "/"), true
+}
+
+// match returns the route for a relative path, sniffing the payload when
+// path is empty. It returns nil if no route is mounted at path.
+func (rt *Router) match(path string, body []byte) (*Route, error) {
+	if path != "" {
+		return rt.route(path), nil
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(body, &fields); err != nil {
+		return nil, errors.New(rt.lang, errors.ErrInvalidJSON, err)
+	}
+	for i := range rt.routes {
+		if _, ok := fields[rt.routes[i].Field]; ok {
+			return &rt.routes[i], nil
+		}
+	}
+	return nil, errors.New(rt.lang, errors.ErrUnknownCallback)
+}
+
+// route returns the route mounted at path, or nil.
+func (rt *Router) route(path string) *Route {
+	for i := range rt.routes {
+		if rt.routes[i].Path == path {
+			return &rt.routes[i]
+		}
+	}
+	return nil
+}
+
+// writeError writes err as an RFC 7807 problem with a status suited to a
+// callback receiver.
+func (rt *Router) writeError(w http.ResponseWriter, r *http.Request, err error) {
+	p := errors.ToProblem(err, rt.lang)
+	p.Status = callbackStatus(err)
+	p.Instance = r.URL.Path
+	w.Header().Set("Content-Type", errors.ProblemContentType)
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	w.WriteHeader(p.Status)
+	_ = json.NewEncoder(w).Encode(p)
+}
+
+// callbackStatus maps a callback processing error to an HTTP status.
+func callbackStatus(err error) int {
+	var maxErr *http.MaxBytesError
+	switch {
+	case errors.As(err, &maxErr), errors.Is(err, errors.ErrCallbackTooLarge):
+		return http.StatusRequestEntityTooLarge
+	case errors.Is(err, errors.ErrInvalidJSON), errors.Is(err, errors.ErrUnknownCallback),
+		errors.Is(err, errors.ErrMissingCallbackField):
+		return http.StatusBadRequest
+	case errors.Is(err, errors.ErrInvalidSignature):
+		return http.StatusUnauthorized
+	case errors.Is(err, errors.ErrIPNotWhitelisted), errors.Is(err, errors.ErrInvalidIPAddress):
+		return http.StatusForbidden
+	}
+	return http.StatusInternalServerError
+}
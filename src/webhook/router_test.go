@@ -0,0 +1,280 @@
+// Copyright 2026 H0llyW00dzZ
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/H0llyW00dzZ/gspay-go-sdk/src/client"
+	"github.com/H0llyW00dzZ/gspay-go-sdk/src/errors"
+	"github.com/H0llyW00dzZ/gspay-go-sdk/src/i18n"
+	"github.com/H0llyW00dzZ/gspay-go-sdk/src/internal/signature"
+	"github.com/H0llyW00dzZ/gspay-go-sdk/src/lifecycle"
+	"github.com/H0llyW00dzZ/gspay-go-sdk/src/payment"
+	"github.com/H0llyW00dzZ/gspay-go-sdk/src/payout"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testSecret = "secret-key"
+
+func idrPaymentBody(status int) string {
+	sig := signature.Generate(fmt.Sprintf("12350000.00TXN123456789%d%s", status, testSecret))
+	return fmt.Sprintf(`{"idrpayment_id":123,"transaction_id":"TXN123456789","amount":"50000.00","status":%d,"remark":"","signature":%q}`, status, sig)
+}
+
+func usdtPaymentBody() string {
+	sig := signature.Generate("CRYPTO12310.50TXN1234567891" + testSecret)
+	return fmt.Sprintf(`{"cryptopayment_id":"CRYPTO123","transaction_id":"TXN123456789","amount":"10.50","status":1,"signature":%q}`, sig)
+}
+
+func idrPayoutBody() string {
+	sig := signature.Generate("4561234567890100000.00TXN123456789" + testSecret)
+	return fmt.Sprintf(`{"idrpayout_id":456,"transaction_id":"TXN123456789","account_name":"John","account_number":"1234567890","amount":100000.00,"completed":true,"payout_success":true,"remark":"","signature":%q}`, sig)
+}
+
+// testRouter returns a router with every built-in route, recording the
+// callbacks and events it handles.
+func testRouter(t *testing.T, opts ...Option) (*Router, *[]any, *[]lifecycle.Event) {
+	t.Helper()
+	c := client.New("auth-key", testSecret)
+	var handled []any
+	var events []lifecycle.Event
+
+	base := []Option{
+		WithRoute(PaymentIDR(payment.NewIDRService(c), func(_ context.Context, cb *payment.IDRCallback) error {
+			handled = append(handled, cb)
+			return nil
+		})),
+		WithRoute(PaymentUSDT(payment.NewUSDTService(c), func(_ context.Context, cb *payment.USDTCallback) error {
+			handled = append(handled, cb)
+			return nil
+		})),
+		WithRoute(PayoutIDR(payout.NewIDRService(c), func(_ context.Context, cb *payout.IDRCallback) error {
+			handled = append(handled, cb)
+			return nil
+		})),
+		WithSubscriber(func(_ context.Context, ev lifecycle.Event) error {
+			events = append(events, ev)
+			return nil
+		}),
+		WithClock(func() time.Time { return time.Date(2026, 1, 26, 15, 0, 0, 0, time.UTC) }),
+	}
+	return NewRouter(append(base, opts...)...), &handled, &events
+}
+
+func post(rt http.Handler, path, body string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodPost, path, strings.NewReader(body))
+	rec := httptest.NewRecorder()
+	rt.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestRouter_Dispatch(t *testing.T) {
+	t.Run("typed paths", func(t *testing.T) {
+		rt, handled, events := testRouter(t)
+
+		for path, body := range map[string]string{
+			"/webhook/payment/idr":  idrPaymentBody(1),
+			"/webhook/payment/usdt": usdtPaymentBody(),
+			"/webhook/payout/idr/":  idrPayoutBody(),
+		} {
+			rec := post(rt, path, body)
+			assert.Equal(t, http.StatusOK, rec.Code, "%s: %s", path, rec.Body)
+			assert.Equal(t, "OK", rec.Body.String())
+		}
+		assert.Len(t, *handled, 3)
+		require.Len(t, *events, 3)
+		for _, ev := range *events {
+			assert.Equal(t, lifecycle.StateSucceeded, ev.State)
+			assert.Equal(t, "TXN123456789", ev.TransactionID)
+			assert.Equal(t, lifecycle.SourceCallback, ev.Source)
+			assert.False(t, ev.At.IsZero())
+		}
+	})
+
+	t.Run("sniffs the payload on the shared URL", func(t *testing.T) {
+		rt, handled, events := testRouter(t)
+
+		require.Equal(t, http.StatusOK, post(rt, "/webhook", idrPaymentBody(2)).Code)
+		require.Equal(t, http.StatusOK, post(rt, "/webhook/", usdtPaymentBody()).Code)
+		require.Equal(t, http.StatusOK, post(rt, "/webhook", idrPayoutBody()).Code)
+
+		require.Len(t, *handled, 3)
+		assert.IsType(t, &payment.IDRCallback{}, (*handled)[0])
+		assert.IsType(t, &payment.USDTCallback{}, (*handled)[1])
+		assert.IsType(t, &payout.IDRCallback{}, (*handled)[2])
+
+		assert.Equal(t, lifecycle.KindPaymentIDR, (*events)[0].Kind)
+		assert.Equal(t, lifecycle.StateFailed, (*events)[0].State)
+		assert.Equal(t, lifecycle.KindPaymentUSDT, (*events)[1].Kind)
+		assert.Equal(t, lifecycle.KindPayoutIDR, (*events)[2].Kind)
+		assert.Equal(t, "456", (*events)[2].ProviderID)
+	})
+
+	t.Run("custom prefix and route", func(t *testing.T) {
+		var verified, got any
+		rt := NewRouter(WithPrefix("gspay/callbacks/"), WithRoute(Route{
+			Path:  "payment/myr",
+			Field: "myrpayment_id",
+			Verify: func(body []byte, _ string, _ i18n.Language) (lifecycle.Event, any, error) {
+				verified = &struct{ Body string }{string(body)}
+				return lifecycle.Event{Kind: "payment_myr", State: lifecycle.StatePending}, verified, nil
+			},
+			Decode: func(body []byte) (any, error) { return body, nil },
+			Handle: func(_ context.Context, callback any) error {
+				got = callback
+				return nil
+			},
+		}))
+
+		assert.Equal(t, http.StatusOK, post(rt, "/gspay/callbacks", `{"myrpayment_id":"1"}`).Code)
+		assert.Same(t, verified, got, "handler receives the verified callback")
+		assert.Equal(t, http.StatusOK, post(rt, "/gspay/callbacks/payment/myr", `{}`).Code)
+		assert.Equal(t, http.StatusNotFound, post(rt, "/webhook", `{}`).Code)
+		assert.Equal(t, http.StatusNotFound, post(rt, "/gspay/callbacksx", `{}`).Code)
+	})
+}
+
+func TestRouter_Errors(t *testing.T) {
+	problem := func(t *testing.T, rec *httptest.ResponseRecorder) errors.Problem {
+		t.Helper()
+		assert.Equal(t, errors.ProblemContentType, rec.Header().Get("Content-Type"))
+		var p errors.Problem
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &p))
+		assert.Equal(t, rec.Code, p.Status)
+		return p
+	}
+
+	t.Run("rejects other methods", func(t *testing.T) {
+		rt, _, _ := testRouter(t)
+		req := httptest.NewRequest(http.MethodGet, "/webhook", nil)
+		rec := httptest.NewRecorder()
+		rt.ServeHTTP(rec, req)
+		assert.Equal(t, http.StatusMethodNotAllowed, rec.Code)
+		assert.Equal(t, http.MethodPost, rec.Header().Get("Allow"))
+	})
+
+	t.Run("unknown path", func(t *testing.T) {
+		rt, _, _ := testRouter(t)
+		assert.Equal(t, http.StatusNotFound, post(rt, "/webhook/payment/thb", idrPaymentBody(1)).Code)
+	})
+
+	t.Run("malformed and unknown payloads", func(t *testing.T) {
+		rt, _, _ := testRouter(t, WithLanguage(i18n.Indonesian))
+
+		rec := post(rt, "/webhook", `not json`)
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		assert.Equal(t, errors.ProblemTypePrefix+"invalid_json", problem(t, rec).Type)
+
+		rec = post(rt, "/webhook", `{"foo":"bar"}`)
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		p := problem(t, rec)
+		assert.Equal(t, errors.ProblemTypePrefix+"unknown_callback", p.Type)
+		assert.Equal(t, "payload callback tidak dikenali", p.Detail)
+		assert.Equal(t, "/webhook", p.Instance)
+
+		rec = post(rt, "/webhook/payment/idr", `{"idrpayment_id":`)
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("bad signature", func(t *testing.T) {
+		rt, handled, events := testRouter(t)
+		body := strings.Replace(idrPaymentBody(1), "50000.00", "90000.00", 1)
+		rec := post(rt, "/webhook", body)
+		assert.Equal(t, http.StatusUnauthorized, rec.Code)
+		problem(t, rec)
+		assert.Empty(t, *handled)
+		assert.Empty(t, *events)
+	})
+
+	t.Run("unavailable credentials are a server error", func(t *testing.T) {
+		c := client.New("", "", client.WithCredentials(client.EnvCredentials("GSPAY_TEST_MISSING_AUTH", "GSPAY_TEST_MISSING_SECRET")))
+		rt := NewRouter(WithRoute(PaymentIDR(payment.NewIDRService(c), nil)))
+		rec := post(rt, "/webhook", idrPaymentBody(1))
+		assert.Equal(t, http.StatusInternalServerError, rec.Code)
+	})
+
+	t.Run("verify errors use the router language", func(t *testing.T) {
+		route := PaymentIDR(payment.NewIDRService(client.New("auth-key", testSecret)), nil)
+		body := strings.Replace(idrPaymentBody(1), "50000.00", "90000.00", 1)
+
+		_, _, err := route.Verify([]byte(body), "", i18n.Indonesian)
+		assert.ErrorIs(t, err, errors.ErrInvalidSignature)
+		assert.Contains(t, err.Error(), i18n.Get(i18n.Indonesian, i18n.MsgInvalidSignature))
+	})
+
+	t.Run("source IP outside the whitelist", func(t *testing.T) {
+		c := client.New("auth-key", testSecret, client.WithCallbackIPWhitelist("203.0.113.7"))
+		rt := NewRouter(
+			WithRoute(PaymentIDR(payment.NewIDRService(c), nil)),
+			WithClientIP(func(r *http.Request) string { return r.Header.Get("X-Real-IP") }),
+		)
+
+		req := httptest.NewRequest(http.MethodPost, "/webhook", strings.NewReader(idrPaymentBody(1)))
+		req.Header.Set("X-Real-IP", "198.51.100.1")
+		rec := httptest.NewRecorder()
+		rt.ServeHTTP(rec, req)
+		assert.Equal(t, http.StatusForbidden, rec.Code)
+
+		req = httptest.NewRequest(http.MethodPost, "/webhook", strings.NewReader(idrPaymentBody(1)))
+		req.Header.Set("X-Real-IP", "203.0.113.7")
+		rec = httptest.NewRecorder()
+		rt.ServeHTTP(rec, req)
+		assert.Equal(t, http.StatusOK, rec.Code)
+	})
+
+	t.Run("oversized body", func(t *testing.T) {
+		small := client.New("auth-key", testSecret, client.WithMaxCallbackSize(16))
+		rt := NewRouter(WithRoute(PaymentIDR(payment.NewIDRService(small), nil)))
+		assert.Equal(t, http.StatusRequestEntityTooLarge, post(rt, "/webhook", idrPaymentBody(1)).Code)
+		assert.Equal(t, http.StatusRequestEntityTooLarge, post(rt, "/webhook/payment/idr", idrPaymentBody(1)).Code)
+	})
+
+	t.Run("each route keeps its client's size limit", func(t *testing.T) {
+		small := client.New("auth-key", testSecret, client.WithMaxCallbackSize(16))
+		large := client.New("auth-key", testSecret)
+		rt := NewRouter(
+			WithRoute(PaymentIDR(payment.NewIDRService(large), nil)),
+			WithRoute(PayoutIDR(payout.NewIDRService(small), nil)),
+		)
+		assert.Equal(t, http.StatusOK, post(rt, "/webhook", idrPaymentBody(1)).Code)
+		rec := post(rt, "/webhook", idrPayoutBody())
+		assert.Equal(t, http.StatusRequestEntityTooLarge, rec.Code)
+		assert.Equal(t, errors.ProblemTypePrefix+"callback_too_large", problem(t, rec).Type)
+	})
+
+	t.Run("handler and subscriber failures ask for redelivery", func(t *testing.T) {
+		c := client.New("auth-key", testSecret)
+		failing := NewRouter(WithRoute(PaymentIDR(payment.NewIDRService(c), func(context.Context, *payment.IDRCallback) error {
+			return fmt.Errorf("wallet unavailable")
+		})))
+		assert.Equal(t, http.StatusInternalServerError, post(failing, "/webhook", idrPaymentBody(1)).Code)
+
+		rt, handled, _ := testRouter(t, WithSubscriber(func(context.Context, lifecycle.Event) error {
+			return fmt.Errorf("bus unavailable")
+		}))
+		assert.Equal(t, http.StatusInternalServerError, post(rt, "/webhook", idrPaymentBody(1)).Code)
+		assert.Len(t, *handled, 1)
+	})
+}
@@ -0,0 +1,225 @@
+// Copyright 2026 H0llyW00dzZ
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webhook
+
+import (
+	"context"
+	"crypto/rand"
+	"sync"
+	"time"
+
+	"github.com/H0llyW00dzZ/gspay-go-sdk/src/errors"
+	"github.com/H0llyW00dzZ/gspay-go-sdk/src/internal/backoff"
+	"github.com/H0llyW00dzZ/gspay-go-sdk/src/lifecycle"
+)
+
+// Default settings of the [Router] workers.
+const (
+	// DefaultWorkers is the default number of concurrent workers.
+	DefaultWorkers = 1
+	// DefaultMaxAttempts is the default number of attempts before a job is buried.
+	DefaultMaxAttempts = 10
+	// DefaultRetryWaitMin is the default wait after the first failed attempt.
+	DefaultRetryWaitMin = time.Second
+	// DefaultRetryWaitMax caps the wait between attempts, jitter included.
+	DefaultRetryWaitMax = 5 * time.Minute
+	// DefaultPollInterval is how often idle workers check for due retries.
+	DefaultPollInterval = time.Second
+)
+
+// WithQueue makes the router acknowledge a callback as soon as it is
+// verified and stored in q. The handlers and the subscriber then run on the
+// workers started by [Router.Run], with retries and a dead-letter store.
+//
+// Without a queue, handlers run before the callback is acknowledged.
+func WithQueue(q Queue) Option {
+	return func(rt *Router) { rt.queue = q }
+}
+
+// WithWorkers sets the number of concurrent workers started by [Router.Run].
+// Default is [DefaultWorkers]. Values below 1 are ignored.
+func WithWorkers(n int) Option {
+	return func(rt *Router) {
+		if n > 0 {
+			rt.workers = n
+		}
+	}
+}
+
+// WithMaxAttempts sets the number of attempts after which a failing job is
+// buried in the dead-letter store. Default is [DefaultMaxAttempts]. Values
+// below 1 are ignored.
+func WithMaxAttempts(n int) Option {
+	return func(rt *Router) {
+		if n > 0 {
+			rt.maxAttempts = n
+		}
+	}
+}
+
+// WithRetryWait sets the exponential backoff between attempts of a job: min
+// after the first failure, doubling with jitter, and never more than max.
+// Default is [DefaultRetryWaitMin] and [DefaultRetryWaitMax]. Invalid values
+// are ignored.
+func WithRetryWait(min, max time.Duration) Option {
+	return func(rt *Router) {
+		if min > 0 && max >= min {
+			rt.retryWaitMin = min
+			rt.retryWaitMax = max
+		}
+	}
+}
+
+// WithPollInterval sets how often idle workers check the queue for retries
+// that have become due. New callbacks wake the workers immediately.
+// Default is [DefaultPollInterval]. Non-positive values are ignored.
+func WithPollInterval(d time.Duration) Option {
+	return func(rt *Router) {
+		if d > 0 {
+			rt.pollInterval = d
+		}
+	}
+}
+
+// WithErrorHandler sets a function called when a queued job fails. dead
+// reports whether the job was buried instead of scheduled for a retry.
+func WithErrorHandler(fn func(job Job, err error, dead bool)) Option {
+	return func(rt *Router) { rt.onError = fn }
+}
+
+// Queue returns the queue set with [WithQueue], or nil.
+func (rt *Router) Queue() Queue { return rt.queue }
+
+// Run processes queued callbacks until ctx is canceled, then waits for the
+// workers to finish their current jobs. It returns ctx.Err(), or nil
+// immediately if no queue is configured.
+//
+// A job whose handler or subscriber fails is retried with exponential
+// backoff, and buried in the dead-letter store after the maximum number of
+// attempts. A job interrupted by cancellation is released without counting
+// the attempt. Jobs left unfinished by a crash are processed again after a
+// restart, so handlers must be idempotent.
+//
+// Example:
+//
+//	q, _ := webhook.OpenFileQueue("gspay-callbacks.wal")
+//	rt := webhook.NewRouter(webhook.WithQueue(q), webhook.WithRoute(...))
+//	go rt.Run(ctx)
+//	http.Handle("/webhook/", rt)
+func (rt *Router) Run(ctx context.Context) error {
+	if rt.queue == nil {
+		return nil
+	}
+	var wg sync.WaitGroup
+	for range rt.workers {
+		wg.Go(func() { rt.work(ctx) })
+	}
+	wg.Wait()
+	return ctx.Err()
+}
+
+// work claims and processes jobs until ctx is canceled.
+func (rt *Router) work(ctx context.Context) {
+	timer := time.NewTimer(rt.pollInterval)
+	defer timer.Stop()
+
+	for ctx.Err() == nil {
+		job, err := rt.queue.Claim(ctx, rt.now())
+		if err == nil && job != nil {
+			rt.process(ctx, job)
+			continue
+		}
+		if err != nil && ctx.Err() == nil && rt.onError != nil {
+			rt.onError(Job{}, err, false)
+		}
+
+		timer.Reset(rt.pollInterval)
+		select {
+		case <-ctx.Done():
+		case <-rt.wake:
+		case <-timer.C:
+		}
+	}
+}
+
+// process runs the handler and subscriber of a claimed job and records the outcome.
+func (rt *Router) process(ctx context.Context, job *Job) {
+	// Queue bookkeeping must complete even while shutting down.
+	store := context.WithoutCancel(ctx)
+
+	err := rt.deliver(ctx, job)
+	if err == nil {
+		if err := rt.queue.Complete(store, job.ID); err != nil && rt.onError != nil {
+			rt.onError(*job, err, false)
+		}
+		return
+	}
+	if ctx.Err() != nil {
+		// Interrupted by shutdown: release the job without counting the attempt.
+		_ = rt.queue.Retry(store, *job)
+		return
+	}
+
+	job.Attempts++
+	job.LastError = err.Error()
+	dead := job.Attempts >= rt.maxAttempts || errors.Is(err, errors.ErrUnknownCallback)
+	if dead {
+		err = errors.Join(err, rt.queue.Bury(store, *job))
+	} else {
+		job.NextAttempt = rt.now().Add(backoff.Exponential(rt.retryWaitMin, rt.retryWaitMax, job.Attempts))
+		err = errors.Join(err, rt.queue.Retry(store, *job))
+	}
+	if rt.onError != nil {
+		rt.onError(*job, err, dead)
+	}
+}
+
+// deliver runs the route handler and the subscriber for a job.
+func (rt *Router) deliver(ctx context.Context, job *Job) error {
+	route := rt.route(job.Route)
+	if route == nil {
+		return errors.New(rt.lang, errors.ErrUnknownCallback, job.Route)
+	}
+	callback, err := route.Decode(job.Body)
+	if err != nil {
+		return err
+	}
+	if err := route.Handle(ctx, callback); err != nil {
+		return err
+	}
+	if rt.subscriber != nil {
+		return rt.subscriber(ctx, job.Event)
+	}
+	return nil
+}
+
+// enqueue stores a verified callback and wakes an idle worker.
+func (rt *Router) enqueue(ctx context.Context, route *Route, body []byte, ev lifecycle.Event) error {
+	job := Job{
+		ID:         rand.Text(),
+		Route:      route.Path,
+		Body:       body,
+		Event:      ev,
+		EnqueuedAt: ev.At,
+	}
+	if err := rt.queue.Enqueue(ctx, job); err != nil {
+		return err
+	}
+	select {
+	case rt.wake <- struct{}{}:
+	default:
+	}
+	return nil
+}
@@ -0,0 +1,176 @@
+// Copyright 2026 H0llyW00dzZ
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webhook
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/H0llyW00dzZ/gspay-go-sdk/src/client"
+	"github.com/H0llyW00dzZ/gspay-go-sdk/src/lifecycle"
+	"github.com/H0llyW00dzZ/gspay-go-sdk/src/payment"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// queuedRouter returns a router with a queued IDR payment route whose
+// handler is fn.
+func queuedRouter(q Queue, fn func(context.Context, *payment.IDRCallback) error, opts ...Option) *Router {
+	c := client.New("auth-key", testSecret)
+	base := []Option{
+		WithQueue(q),
+		WithRoute(PaymentIDR(payment.NewIDRService(c), fn)),
+		WithRetryWait(time.Millisecond, 4*time.Millisecond),
+		WithPollInterval(time.Millisecond),
+	}
+	return NewRouter(append(base, opts...)...)
+}
+
+// runRouter runs rt until the test ends.
+func runRouter(t *testing.T, rt *Router) {
+	t.Helper()
+	ctx, cancel := context.WithCancel(t.Context())
+	done := make(chan error, 1)
+	go func() { done <- rt.Run(ctx) }()
+	t.Cleanup(func() {
+		cancel()
+		assert.ErrorIs(t, <-done, context.Canceled)
+	})
+}
+
+func TestRouter_Queue(t *testing.T) {
+	t.Run("acknowledges before processing", func(t *testing.T) {
+		q := NewMemoryQueue()
+		var calls atomic.Int32
+		var events []lifecycle.Event
+		var mu sync.Mutex
+		rt := queuedRouter(q, func(context.Context, *payment.IDRCallback) error {
+			calls.Add(1)
+			return nil
+		}, WithSubscriber(func(_ context.Context, ev lifecycle.Event) error {
+			mu.Lock()
+			defer mu.Unlock()
+			events = append(events, ev)
+			return nil
+		}))
+
+		rec := post(rt, "/webhook", idrPaymentBody(1))
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Zero(t, calls.Load())
+		assert.Equal(t, 1, q.Len())
+
+		runRouter(t, rt)
+		require.Eventually(t, func() bool { return q.Len() == 0 }, time.Second, time.Millisecond)
+		assert.Equal(t, int32(1), calls.Load())
+		mu.Lock()
+		defer mu.Unlock()
+		require.Len(t, events, 1)
+		assert.Equal(t, lifecycle.StateSucceeded, events[0].State)
+	})
+
+	t.Run("verifies before enqueueing", func(t *testing.T) {
+		q := NewMemoryQueue()
+		rt := queuedRouter(q, nil)
+		body := idrPaymentBody(1)
+		rec := post(rt, "/webhook", body[:len(body)-3]+`x"}`)
+		assert.Equal(t, http.StatusUnauthorized, rec.Code)
+		assert.Zero(t, q.Len())
+	})
+
+	t.Run("retries with backoff", func(t *testing.T) {
+		q := NewMemoryQueue()
+		var calls atomic.Int32
+		var failures atomic.Int32
+		rt := queuedRouter(q, func(context.Context, *payment.IDRCallback) error {
+			if calls.Add(1) < 3 {
+				return fmt.Errorf("wallet unavailable")
+			}
+			return nil
+		}, WithErrorHandler(func(job Job, err error, dead bool) {
+			assert.False(t, dead)
+			assert.Equal(t, "wallet unavailable", job.LastError)
+			failures.Add(1)
+		}))
+
+		require.Equal(t, http.StatusOK, post(rt, "/webhook", idrPaymentBody(1)).Code)
+		runRouter(t, rt)
+		require.Eventually(t, func() bool { return q.Len() == 0 }, time.Second, time.Millisecond)
+		assert.Equal(t, int32(3), calls.Load())
+		assert.Equal(t, int32(2), failures.Load())
+	})
+
+	t.Run("buries after max attempts", func(t *testing.T) {
+		q := NewMemoryQueue()
+		var buried atomic.Bool
+		rt := queuedRouter(q, func(context.Context, *payment.IDRCallback) error {
+			return fmt.Errorf("wallet unavailable")
+		}, WithMaxAttempts(2), WithErrorHandler(func(job Job, err error, dead bool) {
+			if dead {
+				assert.Equal(t, 2, job.Attempts)
+				buried.Store(true)
+			}
+		}))
+
+		require.Equal(t, http.StatusOK, post(rt, "/webhook", idrPaymentBody(1)).Code)
+		runRouter(t, rt)
+		require.Eventually(t, buried.Load, time.Second, time.Millisecond)
+
+		dead, err := q.DeadLetters(t.Context())
+		require.NoError(t, err)
+		require.Len(t, dead, 1)
+		assert.Equal(t, "payment/idr", dead[0].Route)
+		assert.Equal(t, "TXN123456789", dead[0].Event.TransactionID)
+		assert.Zero(t, q.Len())
+	})
+
+	t.Run("delivers after a restart", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "queue.wal")
+		q, err := OpenFileQueue(path)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, post(queuedRouter(q, nil), "/webhook", idrPaymentBody(1)).Code)
+		require.NoError(t, q.Close())
+
+		q, err = OpenFileQueue(path)
+		require.NoError(t, err)
+		defer q.Close()
+		var got atomic.Value
+		rt := queuedRouter(q, func(_ context.Context, cb *payment.IDRCallback) error {
+			got.Store(cb.TransactionID)
+			return nil
+		})
+		runRouter(t, rt)
+		require.Eventually(t, func() bool { return q.Len() == 0 }, time.Second, time.Millisecond)
+		assert.Equal(t, "TXN123456789", got.Load())
+	})
+
+	t.Run("reports enqueue failures", func(t *testing.T) {
+		q, err := OpenFileQueue(filepath.Join(t.TempDir(), "queue.wal"))
+		require.NoError(t, err)
+		require.NoError(t, q.Close())
+		rt := queuedRouter(q, nil)
+		assert.Equal(t, http.StatusInternalServerError, post(rt, "/webhook", idrPaymentBody(1)).Code)
+	})
+
+	t.Run("run without a queue returns", func(t *testing.T) {
+		assert.NoError(t, NewRouter().Run(t.Context()))
+	})
+}